@@ -8,6 +8,7 @@ require (
 	github.com/hashicorp/terraform-plugin-testing v1.14.0
 	github.com/nats-io/jwt/v2 v2.8.0
 	github.com/nats-io/nkeys v0.4.15
+	golang.org/x/crypto v0.47.0
 )
 
 require (
@@ -50,7 +51,6 @@ require (
 	github.com/vmihailenco/msgpack/v5 v5.4.1 // indirect
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 	github.com/zclconf/go-cty v1.17.0 // indirect
-	golang.org/x/crypto v0.47.0 // indirect
 	golang.org/x/mod v0.31.0 // indirect
 	golang.org/x/net v0.48.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
@@ -3,9 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	natsjwt "github.com/nats-io/jwt/v2"
@@ -13,8 +19,11 @@ import (
 )
 
 var _ datasource.DataSource = &AccountDataSource{}
+var _ datasource.DataSourceWithConfigure = &AccountDataSource{}
 
-type AccountDataSource struct{}
+type AccountDataSource struct {
+	jwtSettings
+}
 
 // Shared model types used by both account and system_account data sources.
 
@@ -34,15 +43,17 @@ type AccountLimitsModel struct {
 }
 
 type JetStreamLimitsModel struct {
-	Tier               types.String `tfsdk:"tier"`
-	MemStorage         types.Int64  `tfsdk:"mem_storage"`
-	DiskStorage        types.Int64  `tfsdk:"disk_storage"`
-	Streams            types.Int64  `tfsdk:"streams"`
-	Consumer           types.Int64  `tfsdk:"consumer"`
-	MaxAckPending      types.Int64  `tfsdk:"max_ack_pending"`
-	MemMaxStreamBytes  types.Int64  `tfsdk:"mem_max_stream_bytes"`
-	DiskMaxStreamBytes types.Int64  `tfsdk:"disk_max_stream_bytes"`
-	MaxBytesRequired   types.Bool   `tfsdk:"max_bytes_required"`
+	Tier                        types.String `tfsdk:"tier"`
+	MemStorage                  types.Int64  `tfsdk:"mem_storage"`
+	DiskStorage                 types.Int64  `tfsdk:"disk_storage"`
+	Streams                     types.Int64  `tfsdk:"streams"`
+	Consumer                    types.Int64  `tfsdk:"consumer"`
+	MaxAckPending               types.Int64  `tfsdk:"max_ack_pending"`
+	MemMaxStreamBytes           types.Int64  `tfsdk:"mem_max_stream_bytes"`
+	DiskMaxStreamBytes          types.Int64  `tfsdk:"disk_max_stream_bytes"`
+	MaxBytesRequired            types.Bool   `tfsdk:"max_bytes_required"`
+	MemMaxStreamBytesUnlimited  types.Bool   `tfsdk:"mem_max_stream_bytes_unlimited"`
+	DiskMaxStreamBytesUnlimited types.Bool   `tfsdk:"disk_max_stream_bytes_unlimited"`
 }
 
 type DefaultPermissionsModel struct {
@@ -52,6 +63,34 @@ type DefaultPermissionsModel struct {
 	SubDeny  types.List `tfsdk:"sub_deny"`
 }
 
+type ExportModel struct {
+	Name                 types.String `tfsdk:"name"`
+	Subject              types.String `tfsdk:"subject"`
+	Type                 types.String `tfsdk:"type"`
+	TokenReq             types.Bool   `tfsdk:"token_req"`
+	Advertise            types.Bool   `tfsdk:"advertise"`
+	Revocations          types.Map    `tfsdk:"revocations"`
+	AccountTokenPosition types.Int64  `tfsdk:"account_token_position"`
+	AccountTokenWildcard types.String `tfsdk:"account_token_wildcard"`
+}
+
+type ScopedSigningKeyModel struct {
+	Key         types.String `tfsdk:"key"`
+	Role        types.String `tfsdk:"role"`
+	Description types.String `tfsdk:"description"`
+	Template    types.Object `tfsdk:"template"`
+}
+
+type ScopeTemplateModel struct {
+	PubAllow               types.List   `tfsdk:"pub_allow"`
+	PubDeny                types.List   `tfsdk:"pub_deny"`
+	SubAllow               types.List   `tfsdk:"sub_allow"`
+	SubDeny                types.List   `tfsdk:"sub_deny"`
+	AllowedConnectionTypes types.List   `tfsdk:"allowed_connection_types"`
+	SourceNetworks         types.List   `tfsdk:"source_networks"`
+	Locale                 types.String `tfsdk:"locale"`
+}
+
 type TraceModel struct {
 	Destination types.String `tfsdk:"destination"`
 	Sampling    types.Int64  `tfsdk:"sampling"`
@@ -61,9 +100,16 @@ type AccountDataSourceModel struct {
 	Name               types.String `tfsdk:"name"`
 	Seed               types.String `tfsdk:"seed"`
 	OperatorSeed       types.String `tfsdk:"operator_seed"`
+	OperatorJWT        types.String `tfsdk:"operator_jwt"`
+	EnforceSigningKeys types.Bool   `tfsdk:"enforce_signing_keys"`
+	AllowAcctSigning   types.Bool   `tfsdk:"allow_account_signing"`
 	SigningKeys        types.List   `tfsdk:"signing_keys"`
+	ScopedSigningKeys  types.List   `tfsdk:"scoped_signing_keys"`
 	IssuedAt           types.Int64  `tfsdk:"issued_at"`
+	IssuedAtRFC3339    types.String `tfsdk:"issued_at_rfc3339"`
 	Expires            types.Int64  `tfsdk:"expires"`
+	MinValidity        types.String `tfsdk:"min_validity"`
+	ReferenceUnix      types.Int64  `tfsdk:"reference_unix"`
 	NotBefore          types.Int64  `tfsdk:"not_before"`
 	Description        types.String `tfsdk:"description"`
 	InfoURL            types.String `tfsdk:"info_url"`
@@ -71,10 +117,22 @@ type AccountDataSourceModel struct {
 	NatsLimits         types.Object `tfsdk:"nats_limits"`
 	AccountLimits      types.Object `tfsdk:"account_limits"`
 	JetStreamLimits    types.List   `tfsdk:"jetstream_limits"`
+	JetStreamEnabled   types.Bool   `tfsdk:"jetstream_enabled"`
 	DefaultPermissions types.Object `tfsdk:"default_permissions"`
+	Exports            types.List   `tfsdk:"exports"`
+	ImportFrom         types.Map    `tfsdk:"import_from"`
+	NormalizeSubjects  types.Bool   `tfsdk:"normalize_subjects"`
 	Trace              types.Object `tfsdk:"trace"`
+	ExtraClaims        types.String `tfsdk:"extra_claims"`
+	OmitEmptyFields    types.Bool   `tfsdk:"omit_empty_fields"`
+	EffectiveLimits    types.Object `tfsdk:"effective_limits"`
+	Chain              types.Object `tfsdk:"chain"`
+	AppliedFeatures    types.List   `tfsdk:"applied_features"`
+	Warnings           types.List   `tfsdk:"warnings"`
 	PublicKey          types.String `tfsdk:"public_key"`
 	JWT                types.String `tfsdk:"jwt"`
+	Signature          types.String `tfsdk:"signature"`
+	SignedBySigningKey types.Bool   `tfsdk:"signed_by_signing_key"`
 }
 
 func NewAccountDataSource() datasource.DataSource {
@@ -100,7 +158,8 @@ func accountSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"name": schema.StringAttribute{
 			Required:    true,
-			Description: "Account name.",
+			Description: "Account name. Must be non-empty, without leading or trailing whitespace, and at most 256 characters.",
+			Validators:  []schemavalidator.String{NameValidator()},
 		},
 		"seed": schema.StringAttribute{
 			Required:    true,
@@ -111,22 +170,112 @@ func accountSchemaAttributes() map[string]schema.Attribute {
 		"operator_seed": schema.StringAttribute{
 			Required:    true,
 			Sensitive:   true,
-			Description: "Operator or signing key seed used to sign the account JWT (starts with SO).",
-			Validators:  []schemavalidator.String{SeedTypeValidator(nkeys.PrefixByteOperator)},
+			Description: "Operator or signing key seed used to sign the account JWT (starts with SO). An account seed (SA) is accepted when allow_account_signing is true.",
+			Validators:  []schemavalidator.String{AccountSignerSeedValidator()},
+		},
+		"allow_account_signing": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Allow operator_seed to be an account seed, so the account JWT is issued by another account. nats-server only trusts accounts issued by an operator, so this is for delegation experiments. Default false.",
+		},
+		"operator_jwt": schema.StringAttribute{
+			Optional:    true,
+			Description: "JWT of the operator issuing this account. When set and the operator has strict_signing_key_usage, operator_seed must be one of its signing keys rather than the operator identity key.",
+		},
+		"enforce_signing_keys": schema.BoolAttribute{
+			Optional:    true,
+			Description: "When operator_jwt has strict_signing_key_usage, fail if operator_seed is the operator identity key. Set to false to record a warning instead. Default true.",
 		},
 		"signing_keys": schema.ListAttribute{
 			ElementType: types.StringType,
 			Optional:    true,
 			Description: "Additional signing key public keys for this account.",
+			Validators:  []schemavalidator.List{PublicKeyListValidator(nkeys.PrefixByteAccount)},
+		},
+		"scoped_signing_keys": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Signing keys whose users get their permissions from a template instead of their own JWT. Users issued by a scoped key must not set permissions or limits.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Required:    true,
+						Description: "Signing key public key (starts with A).",
+						Validators:  []schemavalidator.String{PublicKeyTypeValidator(nkeys.PrefixByteAccount)},
+					},
+					"role": schema.StringAttribute{
+						Optional:    true,
+						Description: "Role name for the scope.",
+					},
+					"description": schema.StringAttribute{
+						Optional:    true,
+						Description: "Scope description.",
+					},
+					"template": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Permissions and connection restrictions applied to every user issued by this key.",
+						Attributes: map[string]schema.Attribute{
+							"pub_allow": schema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Subjects allowed for publishing.",
+								Validators:  []schemavalidator.List{SubjectListValidator()},
+							},
+							"pub_deny": schema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Subjects denied for publishing.",
+								Validators:  []schemavalidator.List{SubjectListValidator()},
+							},
+							"sub_allow": schema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Subjects allowed for subscribing.",
+								Validators:  []schemavalidator.List{SubjectListValidator()},
+							},
+							"sub_deny": schema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Subjects denied for subscribing.",
+								Validators:  []schemavalidator.List{SubjectListValidator()},
+							},
+							"allowed_connection_types": schema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Allowed connection types: STANDARD, WEBSOCKET, LEAFNODE, MQTT.",
+							},
+							"source_networks": schema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Allowed source networks (CIDR notation).",
+							},
+							"locale": schema.StringAttribute{
+								Optional:    true,
+								Description: "Timezone for time restrictions (e.g., 'America/New_York').",
+							},
+						},
+					},
+				},
+			},
 		},
 		"issued_at": schema.Int64Attribute{
 			Optional:    true,
 			Description: "JWT issued-at timestamp as Unix seconds. Defaults to 0 (Unix epoch).",
 		},
+		"issued_at_rfc3339": schema.StringAttribute{
+			Optional:    true,
+			Description: "JWT issued-at timestamp in RFC 3339 format, e.g. a commit time such as 2023-01-01T00:00:00Z. Alternative to issued_at; setting both is an error.",
+		},
 		"expires": schema.Int64Attribute{
 			Optional:    true,
 			Description: "JWT expiration timestamp as Unix seconds. Defaults to no expiration.",
 		},
+		"min_validity": schema.StringAttribute{
+			Optional:    true,
+			Description: "Minimum time the JWT must remain valid after reference_unix, as a Go duration such as 1h. Reading fails when expires is sooner. Ignored when expires is not set.",
+		},
+		"reference_unix": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Unix timestamp min_validity is measured from. Defaults to the current time.",
+		},
 		"not_before": schema.Int64Attribute{
 			Optional:    true,
 			Description: "JWT not-before timestamp as Unix seconds. Defaults to issued_at.",
@@ -184,7 +333,7 @@ func accountSchemaAttributes() map[string]schema.Attribute {
 				},
 				"conn": schema.Int64Attribute{
 					Optional:    true,
-					Description: "Maximum connections. -1 for unlimited.",
+					Description: "Maximum concurrent client connections across all users of the account, not per user. -1 for unlimited; 0 disables connections.",
 				},
 				"leaf_node_conn": schema.Int64Attribute{
 					Optional:    true,
@@ -192,9 +341,14 @@ func accountSchemaAttributes() map[string]schema.Attribute {
 				},
 			},
 		},
+		"jetstream_enabled": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Enable JetStream with unlimited memory, disk, streams and consumers when jetstream_limits is not set. Ignored when jetstream_limits is set.",
+		},
 		"jetstream_limits": schema.ListNestedAttribute{
 			Optional:    true,
-			Description: "JetStream limits. Entries without a tier apply globally; entries with a tier (e.g., R1, R3) apply to that replication tier.",
+			Description: "JetStream limits. Either a single entry without a tier that applies globally, or entries with a tier (e.g., R1, R3) that apply to that replication tier. The two forms cannot be combined.",
+			Validators:  []schemavalidator.List{JetStreamTiersValidator()},
 			NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
 					"tier": schema.StringAttribute{
@@ -223,11 +377,19 @@ func accountSchemaAttributes() map[string]schema.Attribute {
 					},
 					"mem_max_stream_bytes": schema.Int64Attribute{
 						Optional:    true,
-						Description: "Maximum bytes per memory stream. 0 = unlimited.",
+						Description: "Maximum bytes per memory stream. 0 = unlimited (unlike mem_storage, where 0 disables memory storage).",
 					},
 					"disk_max_stream_bytes": schema.Int64Attribute{
 						Optional:    true,
-						Description: "Maximum bytes per disk stream. 0 = unlimited.",
+						Description: "Maximum bytes per disk stream. 0 = unlimited (unlike disk_storage, where 0 disables disk storage).",
+					},
+					"mem_max_stream_bytes_unlimited": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Explicitly allow memory streams of any size. Conflicts with a non-zero mem_max_stream_bytes.",
+					},
+					"disk_max_stream_bytes_unlimited": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Explicitly allow disk streams of any size. Conflicts with a non-zero disk_max_stream_bytes.",
 					},
 					"max_bytes_required": schema.BoolAttribute{
 						Optional:    true,
@@ -244,24 +406,81 @@ func accountSchemaAttributes() map[string]schema.Attribute {
 					ElementType: types.StringType,
 					Optional:    true,
 					Description: "Subjects allowed for publishing.",
+					Validators:  []schemavalidator.List{SubjectListValidator()},
 				},
 				"pub_deny": schema.ListAttribute{
 					ElementType: types.StringType,
 					Optional:    true,
 					Description: "Subjects denied for publishing.",
+					Validators:  []schemavalidator.List{SubjectListValidator()},
 				},
 				"sub_allow": schema.ListAttribute{
 					ElementType: types.StringType,
 					Optional:    true,
 					Description: "Subjects allowed for subscribing.",
+					Validators:  []schemavalidator.List{SubjectListValidator()},
 				},
 				"sub_deny": schema.ListAttribute{
 					ElementType: types.StringType,
 					Optional:    true,
 					Description: "Subjects denied for subscribing.",
+					Validators:  []schemavalidator.List{SubjectListValidator()},
 				},
 			},
 		},
+		"exports": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Subjects this account exports to other accounts.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Export name.",
+					},
+					"subject": schema.StringAttribute{
+						Required:    true,
+						Description: "Exported subject.",
+					},
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "Export type: service or stream.",
+						Validators:  []schemavalidator.String{ExportTypeValidator()},
+					},
+					"token_req": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Require an activation token to import this export. Default false.",
+					},
+					"advertise": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Advertise the export so it is listed publicly for discovery. Default false.",
+					},
+					"account_token_position": schema.Int64Attribute{
+						Optional:    true,
+						Description: "1-based position of a * token in subject that importers must fill with their own account public key.",
+					},
+					"account_token_wildcard": schema.StringAttribute{
+						Optional:    true,
+						Description: "Placeholder token in subject that importers must fill with their own account public key, e.g. ACCOUNT in orders.ACCOUNT.>. The placeholder is replaced with * and account_token_position is computed from it. Cannot be combined with account_token_position.",
+					},
+					"revocations": schema.MapAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Description: "Map of importing account public keys to Unix timestamps. Activations for that account issued before the timestamp are revoked.",
+						Validators:  []schemavalidator.Map{PublicKeyMapKeysValidator(nkeys.PrefixByteAccount, true)},
+					},
+				},
+			},
+		},
+		"import_from": schema.MapAttribute{
+			ElementType: types.ListType{ElemType: types.StringType},
+			Optional:    true,
+			Description: "Map of exporting account public keys to lists of subjects to import from them. Each subject becomes a service import named after the subject.",
+			Validators:  []schemavalidator.Map{PublicKeyMapKeysValidator(nkeys.PrefixByteAccount, false)},
+		},
+		"normalize_subjects": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Trim surrounding whitespace from export and import_from subjects and reject malformed ones (empty tokens such as a trailing dot, misplaced wildcards) before signing. Permission subjects are always validated. Default false.",
+		},
 		"trace": schema.SingleNestedAttribute{
 			Optional:    true,
 			Description: "Message trace configuration.",
@@ -276,6 +495,35 @@ func accountSchemaAttributes() map[string]schema.Attribute {
 				},
 			},
 		},
+		"extra_claims": schema.StringAttribute{
+			Optional:    true,
+			Description: "JSON object of additional top-level claim fields merged into the JWT payload before signing, for experimental server features. Standard fields (iss, sub, iat, exp, nbf, jti, aud, name, nats) cannot be set.",
+			Validators:  []schemavalidator.String{ExtraClaimsValidator()},
+		},
+		"omit_empty_fields": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Omit zero-valued optional claim fields from the JWT payload, as the nats jwt library does. Default true. Set to false to write explicit zero values for servers that expect every field to be present.",
+		},
+		"effective_limits": schema.ObjectAttribute{
+			AttributeTypes: effectiveLimitsAttrTypes,
+			Computed:       true,
+			Description:    "NATS and account limits as encoded in the JWT, after defaults are applied. -1 means unlimited.",
+		},
+		"chain": schema.ObjectAttribute{
+			AttributeTypes: accountChainAttrTypes,
+			Computed:       true,
+			Description:    "Issuance chain for audit: subject, issuer and, when operator_jwt is set, the operator and whether the issuer is the operator identity key (signed_by_root) rather than one of its signing keys.",
+		},
+		"applied_features": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "Optional blocks that were set and applied to the JWT, e.g. nats_limits, jetstream_limits or trace.",
+		},
+		"warnings": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "Advisory notes about the configuration, such as exports that require activation tokens.",
+		},
 		"public_key": schema.StringAttribute{
 			Computed:    true,
 			Description: "The account's public key.",
@@ -284,6 +532,14 @@ func accountSchemaAttributes() map[string]schema.Attribute {
 			Computed:    true,
 			Description: "The signed account JWT.",
 		},
+		"signature": schema.StringAttribute{
+			Computed:    true,
+			Description: "Signature segment of the account JWT (the part after the last dot), base64url encoded without padding. It signs the header and payload segments joined by a dot.",
+		},
+		"signed_by_signing_key": schema.BoolAttribute{
+			Computed:    true,
+			Description: "True when operator_seed is one of the operator's signing keys rather than its identity key. Null unless operator_jwt is set, since the identity key is otherwise unknown.",
+		},
 	}
 }
 
@@ -294,7 +550,7 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	claims, pub, err := buildAccountClaims(ctx, data, resp)
+	claims, pub, err := buildAccountClaims(ctx, &data, d.issuedAtRoundTo, resp)
 	if err != nil || resp.Diagnostics.HasError() {
 		return
 	}
@@ -305,19 +561,265 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	jwtString, err := encodeDeterministic(claims, operatorKP)
+	checkOperatorSigner(ctx, &data, operatorKP, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extra, err := parseExtraClaims(data.ExtraClaims.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Extra Claims", fmt.Sprintf("extra_claims %s", err))
+		return
+	}
+
+	jwtString, err := encodeDeterministicWithOptions(claims, operatorKP, extra, omitEmptyFields(data.OmitEmptyFields))
 	if err != nil {
 		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode account JWT: %s", err))
 		return
 	}
 
+	d.checkJWTSize("account", jwtString, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	effectiveLimits, diags := effectiveLimitsValue(claims.Limits)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chain, diags := accountChainValue(claims, data.OperatorJWT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.PublicKey = types.StringValue(pub)
 	data.JWT = types.StringValue(jwtString)
+	data.Signature = types.StringValue(jwtSignature(jwtString))
+	data.EffectiveLimits = effectiveLimits
+	data.Chain = chain
+	data.SignedBySigningKey = accountSignedBySigningKey(claims, chain)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// buildAccountClaims constructs account claims from the data model. Shared by account and system_account.
-func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *datasource.ReadResponse) (*natsjwt.AccountClaims, string, error) {
+// checkOperatorSigner rejects account seeds as signers unless allow_account_signing
+// is set, and, when the operator JWT requires signing keys, account issuance with
+// any key that is not one of the operator's signing keys. With enforce_signing_keys = false the latter finding is
+// added to data.Warnings instead. A null operator_jwt skips the operator check.
+func checkOperatorSigner(ctx context.Context, data *AccountDataSourceModel, operatorKP nkeys.KeyPair, diags *diag.Diagnostics) {
+	signerPub, err := operatorKP.PublicKey()
+	if err != nil {
+		diags.AddError("Public Key Error", fmt.Sprintf("Failed to get operator seed public key: %s", err))
+		return
+	}
+	if nkeys.IsValidPublicAccountKey(signerPub) && !data.AllowAcctSigning.ValueBool() {
+		diags.AddAttributeError(path.Root("operator_seed"), "Wrong NKey Seed Type",
+			"Expected operator seed, got account seed; set allow_account_signing = true to sign with an account key")
+		return
+	}
+
+	operatorJWT := data.OperatorJWT
+	if operatorJWT.IsNull() || operatorJWT.IsUnknown() {
+		return
+	}
+
+	opClaims, err := natsjwt.DecodeOperatorClaims(operatorJWT.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("operator_jwt"), "Invalid Operator JWT", fmt.Sprintf("Failed to decode operator JWT: %s", err))
+		return
+	}
+	if !opClaims.StrictSigningKeyUsage {
+		return
+	}
+
+	var problem string
+	switch {
+	case signerPub == opClaims.Subject:
+		problem = "is signed with the operator identity key"
+	case !opClaims.SigningKeys.Contains(signerPub):
+		problem = fmt.Sprintf("is signed with %s, which is not one of its signing keys", signerPub)
+	default:
+		return
+	}
+	if data.EnforceSigningKeys.IsNull() || data.EnforceSigningKeys.ValueBool() {
+		diags.AddAttributeError(path.Root("operator_seed"), "Signing Key Required",
+			fmt.Sprintf("Operator %s has strict_signing_key_usage enabled, so accounts must be signed with one of its signing keys, but this account %s", opClaims.Subject, problem))
+		return
+	}
+
+	var warnings []string
+	diags.Append(data.Warnings.ElementsAs(ctx, &warnings, false)...)
+	warnings = append(warnings, fmt.Sprintf("operator %s has strict_signing_key_usage enabled but this account %s; servers will reject it", opClaims.Subject, problem))
+	warningsTF, d := types.ListValueFrom(ctx, types.StringType, warnings)
+	diags.Append(d...)
+	data.Warnings = warningsTF
+}
+
+// buildUserScope converts a scoped_signing_keys entry into a user scope. Empty lists
+// leave the template field unset, as they do on natsjwt_user.
+func buildUserScope(ctx context.Context, sk ScopedSigningKeyModel, diags *diag.Diagnostics) (*natsjwt.UserScope, error) {
+	scope := natsjwt.NewUserScope()
+	scope.Key = sk.Key.ValueString()
+	scope.Role = sk.Role.ValueString()
+	scope.Description = sk.Description.ValueString()
+
+	if sk.Template.IsNull() {
+		return scope, nil
+	}
+	var tmpl ScopeTemplateModel
+	diags.Append(sk.Template.As(ctx, &tmpl, objectAsOptions)...)
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to read scope template")
+	}
+
+	var pubAllow, pubDeny, subAllow, subDeny, connTypes, networks []string
+	for _, l := range []struct {
+		list   types.List
+		target *[]string
+	}{
+		{tmpl.PubAllow, &pubAllow},
+		{tmpl.PubDeny, &pubDeny},
+		{tmpl.SubAllow, &subAllow},
+		{tmpl.SubDeny, &subDeny},
+		{tmpl.AllowedConnectionTypes, &connTypes},
+		{tmpl.SourceNetworks, &networks},
+	} {
+		if l.list.IsNull() {
+			continue
+		}
+		diags.Append(l.list.ElementsAs(ctx, l.target, false)...)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to read scope template")
+		}
+	}
+
+	scope.Template.Pub = buildPermission(pubAllow, pubDeny)
+	scope.Template.Sub = buildPermission(subAllow, subDeny)
+	if len(connTypes) > 0 {
+		scope.Template.AllowedConnectionTypes = connTypes
+	}
+	if len(networks) > 0 {
+		scope.Template.Src = networks
+	}
+	scope.Template.Locale = tmpl.Locale.ValueString()
+	return scope, nil
+}
+
+// exportAccountToken resolves the account token of an export. With
+// account_token_wildcard, the single token matching the placeholder becomes "*"
+// and its position is returned; with account_token_position, the position must
+// point at a "*" token. It returns the subject to encode and a 1-based position,
+// or 0 when the export has no account token.
+func exportAccountToken(e ExportModel) (string, uint, error) {
+	subject := e.Subject.ValueString()
+	tokens := strings.Split(subject, ".")
+
+	switch {
+	case !e.AccountTokenWildcard.IsNull() && !e.AccountTokenPosition.IsNull():
+		return "", 0, fmt.Errorf("account_token_wildcard and account_token_position cannot both be set")
+	case !e.AccountTokenWildcard.IsNull():
+		placeholder := e.AccountTokenWildcard.ValueString()
+		if placeholder == "" || strings.ContainsAny(placeholder, ".*> \t") {
+			return "", 0, fmt.Errorf("account_token_wildcard %q must be a single literal token", placeholder)
+		}
+		pos := 0
+		for i, token := range tokens {
+			if token != placeholder {
+				continue
+			}
+			if pos != 0 {
+				return "", 0, fmt.Errorf("account_token_wildcard %q appears more than once in the subject", placeholder)
+			}
+			pos = i + 1
+		}
+		if pos == 0 {
+			return "", 0, fmt.Errorf("account_token_wildcard %q is not a token of the subject", placeholder)
+		}
+		tokens[pos-1] = "*"
+		return strings.Join(tokens, "."), uint(pos), nil
+	case !e.AccountTokenPosition.IsNull():
+		pos := e.AccountTokenPosition.ValueInt64()
+		if pos < 1 || pos > int64(len(tokens)) || tokens[pos-1] != "*" {
+			return "", 0, fmt.Errorf("account_token_position %d must point at a '*' token in the subject", pos)
+		}
+		return subject, uint(pos), nil
+	default:
+		return subject, 0, nil
+	}
+}
+
+var accountChainAttrTypes = map[string]attr.Type{
+	"subject":        types.StringType,
+	"issuer":         types.StringType,
+	"operator":       types.StringType,
+	"signed_by_root": types.BoolType,
+}
+
+// accountChainValue reports who issued encoded account claims. Without an
+// operator JWT there is no way to tell the identity key from a signing key, so
+// operator and signed_by_root are left null.
+func accountChainValue(claims *natsjwt.AccountClaims, operatorJWT types.String) (types.Object, diag.Diagnostics) {
+	operator := types.StringNull()
+	signedByRoot := types.BoolNull()
+	if !operatorJWT.IsNull() && !operatorJWT.IsUnknown() {
+		opClaims, err := natsjwt.DecodeOperatorClaims(operatorJWT.ValueString())
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddAttributeError(path.Root("operator_jwt"), "Invalid Operator JWT", fmt.Sprintf("Failed to decode operator JWT: %s", err))
+			return types.ObjectNull(accountChainAttrTypes), diags
+		}
+		operator = types.StringValue(opClaims.Subject)
+		signedByRoot = types.BoolValue(claims.Issuer == opClaims.Subject)
+	}
+
+	return types.ObjectValue(accountChainAttrTypes, map[string]attr.Value{
+		"subject":        types.StringValue(claims.Subject),
+		"issuer":         types.StringValue(claims.Issuer),
+		"operator":       operator,
+		"signed_by_root": signedByRoot,
+	})
+}
+
+// accountSignedBySigningKey compares the account issuer with the operator
+// recorded in chain, which is null without an operator JWT.
+func accountSignedBySigningKey(claims *natsjwt.AccountClaims, chain types.Object) types.Bool {
+	operator, _ := chain.Attributes()["operator"].(types.String)
+	return signedBySigningKey(claims.Issuer, operator.ValueString())
+}
+
+var effectiveLimitsAttrTypes = map[string]attr.Type{
+	"subs":             types.Int64Type,
+	"data":             types.Int64Type,
+	"payload":          types.Int64Type,
+	"imports":          types.Int64Type,
+	"exports":          types.Int64Type,
+	"wildcard_exports": types.BoolType,
+	"disallow_bearer":  types.BoolType,
+	"conn":             types.Int64Type,
+	"leaf_node_conn":   types.Int64Type,
+}
+
+// effectiveLimitsValue reports the NATS and account limits of built account claims.
+func effectiveLimitsValue(limits natsjwt.OperatorLimits) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(effectiveLimitsAttrTypes, map[string]attr.Value{
+		"subs":             types.Int64Value(limits.Subs),
+		"data":             types.Int64Value(limits.Data),
+		"payload":          types.Int64Value(limits.Payload),
+		"imports":          types.Int64Value(limits.Imports),
+		"exports":          types.Int64Value(limits.Exports),
+		"wildcard_exports": types.BoolValue(limits.WildcardExports),
+		"disallow_bearer":  types.BoolValue(limits.DisallowBearer),
+		"conn":             types.Int64Value(limits.Conn),
+		"leaf_node_conn":   types.Int64Value(limits.LeafNodeConn),
+	})
+}
+
+// buildAccountClaims constructs account claims from the data model. Shared by account, system_account
+// and leafnode_account.
+// It records the optional blocks that were set in data.AppliedFeatures and advisory notes in data.Warnings.
+func buildAccountClaims(ctx context.Context, data *AccountDataSourceModel, roundTo time.Duration, resp *datasource.ReadResponse) (*natsjwt.AccountClaims, string, error) {
 	accountKP, err := keypairFromSeed(data.Seed.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid Account Seed", fmt.Sprintf("Failed to parse account seed: %s", err))
@@ -332,7 +834,17 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 
 	claims := natsjwt.NewAccountClaims(pub)
 	claims.Name = data.Name.ValueString()
-	applyTemporalClaimsDefaults(claims.Claims(), data.IssuedAt, data.Expires, data.NotBefore)
+	applied := []string{}
+	warnings := []string{}
+	issuedAt := resolveIssuedAt(data.IssuedAt, data.IssuedAtRFC3339, roundTo, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return nil, "", fmt.Errorf("invalid issued_at")
+	}
+	applyTemporalClaimsDefaults(claims.Claims(), issuedAt, data.Expires, data.NotBefore)
+	checkMinValidity(data.Expires, data.MinValidity, data.ReferenceUnix, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return nil, "", fmt.Errorf("insufficient validity")
+	}
 
 	if !data.SigningKeys.IsNull() {
 		var signingKeys []string
@@ -345,6 +857,27 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 		}
 	}
 
+	if !data.ScopedSigningKeys.IsNull() {
+		var scopedKeys []ScopedSigningKeyModel
+		resp.Diagnostics.Append(data.ScopedSigningKeys.ElementsAs(ctx, &scopedKeys, false)...)
+		if resp.Diagnostics.HasError() {
+			return nil, "", fmt.Errorf("failed to read scoped signing keys")
+		}
+		for i, sk := range scopedKeys {
+			key := sk.Key.ValueString()
+			if claims.SigningKeys.Contains(key) {
+				resp.Diagnostics.AddAttributeError(path.Root("scoped_signing_keys").AtListIndex(i).AtName("key"), "Duplicate Signing Key",
+					fmt.Sprintf("Signing key %s is listed more than once across signing_keys and scoped_signing_keys", key))
+				return nil, "", fmt.Errorf("duplicate signing key")
+			}
+			scope, err := buildUserScope(ctx, sk, &resp.Diagnostics)
+			if err != nil {
+				return nil, "", err
+			}
+			claims.SigningKeys.AddScopedSigner(scope)
+		}
+	}
+
 	if !data.Description.IsNull() {
 		claims.Description = data.Description.ValueString()
 	}
@@ -364,6 +897,7 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 
 	// NATS limits
 	if !data.NatsLimits.IsNull() {
+		applied = append(applied, "nats_limits")
 		var nl NatsLimitsModel
 		resp.Diagnostics.Append(data.NatsLimits.As(ctx, &nl, objectAsOptions)...)
 		if resp.Diagnostics.HasError() {
@@ -388,6 +922,7 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 
 	// Account limits
 	if !data.AccountLimits.IsNull() {
+		applied = append(applied, "account_limits")
 		var al AccountLimitsModel
 		resp.Diagnostics.Append(data.AccountLimits.As(ctx, &al, objectAsOptions)...)
 		if resp.Diagnostics.HasError() {
@@ -411,11 +946,17 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 		if !al.DisallowBearer.IsNull() {
 			claims.Limits.DisallowBearer = al.DisallowBearer.ValueBool()
 		}
+		if claims.Limits.DisallowBearer {
+			warnings = append(warnings, "account_limits.disallow_bearer is true; users of this account with bearer_token = true will be rejected by the server")
+		}
 		if !al.Conn.IsNull() {
 			claims.Limits.Conn = al.Conn.ValueInt64()
 		} else {
 			claims.Limits.Conn = -1
 		}
+		if claims.Limits.Conn == 0 {
+			warnings = append(warnings, "account_limits.conn is 0; connections disabled: no user of this account can connect")
+		}
 		if !al.LeafNodeConn.IsNull() {
 			claims.Limits.LeafNodeConn = al.LeafNodeConn.ValueInt64()
 		} else {
@@ -425,6 +966,7 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 
 	// JetStream limits
 	if !data.JetStreamLimits.IsNull() {
+		applied = append(applied, "jetstream_limits")
 		var jsLimits []JetStreamLimitsModel
 		resp.Diagnostics.Append(data.JetStreamLimits.ElementsAs(ctx, &jsLimits, false)...)
 		if resp.Diagnostics.HasError() {
@@ -460,10 +1002,24 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 			if !jsl.DiskMaxStreamBytes.IsNull() {
 				limit.DiskMaxStreamBytes = jsl.DiskMaxStreamBytes.ValueInt64()
 			}
+			// The jwt library treats 0 as "no per-stream limit", so the unlimited
+			// flags only need to reject a conflicting explicit limit.
+			if jsl.MemMaxStreamBytesUnlimited.ValueBool() && limit.MemoryMaxStreamBytes > 0 {
+				resp.Diagnostics.AddError("Conflicting JetStream Limits",
+					fmt.Sprintf("mem_max_stream_bytes_unlimited is true but mem_max_stream_bytes is %d", limit.MemoryMaxStreamBytes))
+				return nil, "", fmt.Errorf("conflicting jetstream limits")
+			}
+			if jsl.DiskMaxStreamBytesUnlimited.ValueBool() && limit.DiskMaxStreamBytes > 0 {
+				resp.Diagnostics.AddError("Conflicting JetStream Limits",
+					fmt.Sprintf("disk_max_stream_bytes_unlimited is true but disk_max_stream_bytes is %d", limit.DiskMaxStreamBytes))
+				return nil, "", fmt.Errorf("conflicting jetstream limits")
+			}
 			if !jsl.MaxBytesRequired.IsNull() {
 				limit.MaxBytesRequired = jsl.MaxBytesRequired.ValueBool()
 			}
 
+			// JetStreamTiersValidator rejects mixing the two forms, so an account
+			// ends up with either global limits or tiered limits, never both.
 			tier := jsl.Tier.ValueString()
 			if tier == "" || jsl.Tier.IsNull() {
 				// Global limits
@@ -476,10 +1032,19 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 				claims.Limits.JetStreamTieredLimits[tier] = limit
 			}
 		}
+	} else if data.JetStreamEnabled.ValueBool() {
+		claims.Limits.JetStreamLimits = natsjwt.JetStreamLimits{
+			MemoryStorage: -1,
+			DiskStorage:   -1,
+			Streams:       -1,
+			Consumer:      -1,
+			MaxAckPending: -1,
+		}
 	}
 
 	// Default permissions
 	if !data.DefaultPermissions.IsNull() {
+		applied = append(applied, "default_permissions")
 		var dp DefaultPermissionsModel
 		resp.Diagnostics.Append(data.DefaultPermissions.As(ctx, &dp, objectAsOptions)...)
 		if resp.Diagnostics.HasError() {
@@ -505,8 +1070,98 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 		claims.DefaultPermissions.Sub = buildPermission(subAllow, subDeny)
 	}
 
+	// Exports
+	if !data.Exports.IsNull() {
+		applied = append(applied, "exports")
+		var exports []ExportModel
+		resp.Diagnostics.Append(data.Exports.ElementsAs(ctx, &exports, false)...)
+		if resp.Diagnostics.HasError() {
+			return nil, "", fmt.Errorf("failed to read exports")
+		}
+		for _, e := range exports {
+			if data.NormalizeSubjects.ValueBool() {
+				normalized, err := normalizeSubject(e.Subject.ValueString())
+				if err != nil {
+					resp.Diagnostics.AddError("Invalid Subject", fmt.Sprintf("Export %q: %s", e.Subject.ValueString(), err))
+					return nil, "", err
+				}
+				e.Subject = types.StringValue(normalized)
+			}
+			subject, tokenPos, err := exportAccountToken(e)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid Account Token Position", fmt.Sprintf("Export %q: %s", e.Subject.ValueString(), err))
+				return nil, "", fmt.Errorf("invalid account token position")
+			}
+			export := &natsjwt.Export{
+				Name:                 e.Name.ValueString(),
+				Subject:              natsjwt.Subject(subject),
+				AccountTokenPosition: tokenPos,
+			}
+			if e.Type.ValueString() == "stream" {
+				export.Type = natsjwt.Stream
+			} else {
+				export.Type = natsjwt.Service
+			}
+			if !e.TokenReq.IsNull() {
+				export.TokenReq = e.TokenReq.ValueBool()
+			}
+			if export.TokenReq {
+				warnings = append(warnings, fmt.Sprintf("export %q requires activation tokens; importing accounts need an activation JWT signed by this account, which this provider does not issue", subject))
+			}
+			if !e.Advertise.IsNull() {
+				export.Advertise = e.Advertise.ValueBool()
+			}
+			if !e.Revocations.IsNull() {
+				var revocations map[string]int64
+				resp.Diagnostics.Append(e.Revocations.ElementsAs(ctx, &revocations, false)...)
+				if resp.Diagnostics.HasError() {
+					return nil, "", fmt.Errorf("failed to read export revocations")
+				}
+				export.Revocations = natsjwt.RevocationList{}
+				for pubKey, ts := range revocations {
+					export.Revocations.Revoke(pubKey, time.Unix(ts, 0))
+				}
+			}
+			claims.Exports.Add(export)
+		}
+	}
+
+	// Imports derived from import_from
+	if !data.ImportFrom.IsNull() {
+		applied = append(applied, "import_from")
+		var importFrom map[string][]string
+		resp.Diagnostics.Append(data.ImportFrom.ElementsAs(ctx, &importFrom, false)...)
+		if resp.Diagnostics.HasError() {
+			return nil, "", fmt.Errorf("failed to read import_from")
+		}
+		exporters := make([]string, 0, len(importFrom))
+		for exporter := range importFrom {
+			exporters = append(exporters, exporter)
+		}
+		sort.Strings(exporters)
+		for _, exporter := range exporters {
+			for _, subject := range importFrom[exporter] {
+				if data.NormalizeSubjects.ValueBool() {
+					normalized, err := normalizeSubject(subject)
+					if err != nil {
+						resp.Diagnostics.AddError("Invalid Subject", fmt.Sprintf("Import %q from %s: %s", subject, exporter, err))
+						return nil, "", err
+					}
+					subject = normalized
+				}
+				claims.Imports.Add(&natsjwt.Import{
+					Name:    subject,
+					Subject: natsjwt.Subject(subject),
+					Account: exporter,
+					Type:    natsjwt.Service,
+				})
+			}
+		}
+	}
+
 	// Trace
 	if !data.Trace.IsNull() {
+		applied = append(applied, "trace")
 		var t TraceModel
 		resp.Diagnostics.Append(data.Trace.As(ctx, &t, objectAsOptions)...)
 		if resp.Diagnostics.HasError() {
@@ -522,5 +1177,19 @@ func buildAccountClaims(ctx context.Context, data AccountDataSourceModel, resp *
 		}
 	}
 
+	appliedTF, diags := types.ListValueFrom(ctx, types.StringType, applied)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return nil, "", fmt.Errorf("failed to record applied features")
+	}
+	data.AppliedFeatures = appliedTF
+
+	warningsTF, diags := types.ListValueFrom(ctx, types.StringType, warnings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return nil, "", fmt.Errorf("failed to record warnings")
+	}
+	data.Warnings = warningsTF
+
 	return claims, pub, nil
 }
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+var _ datasource.DataSource = &AccountCredsDataSource{}
+var _ datasource.DataSourceWithConfigure = &AccountCredsDataSource{}
+
+type AccountCredsDataSource struct {
+	jwtSettings
+}
+
+type AccountCredsUserModel struct {
+	Seed                   types.String `tfsdk:"seed"`
+	Expires                types.Int64  `tfsdk:"expires"`
+	Permissions            types.Object `tfsdk:"permissions"`
+	SubjectNamespace       types.String `tfsdk:"subject_namespace"`
+	Limits                 types.Object `tfsdk:"limits"`
+	BearerToken            types.Bool   `tfsdk:"bearer_token"`
+	AllowedConnectionTypes types.List   `tfsdk:"allowed_connection_types"`
+	SourceNetworks         types.List   `tfsdk:"source_networks"`
+	Tags                   types.List   `tfsdk:"tags"`
+}
+
+type AccountCredsDataSourceModel struct {
+	AccountSeed   types.String `tfsdk:"account_seed"`
+	IssuerAccount types.String `tfsdk:"issuer_account"`
+	IssuedAt      types.Int64  `tfsdk:"issued_at"`
+	Users         types.Map    `tfsdk:"users"`
+	PublicKeys    types.Map    `tfsdk:"public_keys"`
+	JWTs          types.Map    `tfsdk:"jwts"`
+	Creds         types.Map    `tfsdk:"creds"`
+}
+
+func NewAccountCredsDataSource() datasource.DataSource {
+	return &AccountCredsDataSource{}
+}
+
+func (d *AccountCredsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_creds"
+}
+
+func (d *AccountCredsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates signed user JWTs and creds for several users of one account in a single data source.",
+		Attributes: map[string]schema.Attribute{
+			"account_seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Account or signing key seed used to sign every user JWT (starts with SA).",
+				Validators:  []schemavalidator.String{SeedTypeValidator(nkeys.PrefixByteAccount)},
+			},
+			"issuer_account": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account public key. Set this when account_seed is a signing key instead of the account key.",
+			},
+			"issued_at": schema.Int64Attribute{
+				Optional:    true,
+				Description: "JWT issued-at timestamp as Unix seconds for every user. Defaults to 0 (Unix epoch).",
+			},
+			"users": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Users to issue, keyed by user name. Each entry takes a subset of the natsjwt_user arguments.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"seed": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "User NKey seed (starts with SU).",
+							Validators:  []schemavalidator.String{SeedTypeValidator(nkeys.PrefixByteUser)},
+						},
+						"expires": schema.Int64Attribute{
+							Optional:    true,
+							Description: "JWT expiration timestamp as Unix seconds. Defaults to no expiration.",
+						},
+						"permissions": userPermissionsAttribute(),
+						"subject_namespace": schema.StringAttribute{
+							Optional:    true,
+							Description: "Subject prefix without wildcards. When permissions is not set, the user may only publish and subscribe under <namespace>.>.",
+							Validators:  []schemavalidator.String{SubjectNamespaceValidator()},
+						},
+						"limits": userLimitsAttribute(),
+						"bearer_token": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Allow bearer token authentication. Default false.",
+						},
+						"allowed_connection_types": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Allowed connection types: STANDARD, WEBSOCKET, LEAFNODE, MQTT.",
+						},
+						"source_networks": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Allowed source networks (CIDR notation).",
+						},
+						"tags": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tags for the user.",
+						},
+					},
+				},
+			},
+			"public_keys": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "User public keys, keyed by user name.",
+			},
+			"jwts": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Signed user JWTs, keyed by user name.",
+			},
+			"creds": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "NATS user credentials file contents, keyed by user name.",
+			},
+		},
+	}
+}
+
+func (d *AccountCredsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountCredsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountKP, err := keypairFromSeed(data.AccountSeed.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Account Seed", fmt.Sprintf("Failed to parse account seed: %s", err))
+		return
+	}
+
+	var users map[string]AccountCredsUserModel
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	publicKeys := make(map[string]string, len(users))
+	jwts := make(map[string]string, len(users))
+	creds := make(map[string]string, len(users))
+	for _, name := range names {
+		if err := validateName(name); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("users").AtMapKey(name), "Invalid Name", "Name "+err.Error())
+			return
+		}
+
+		u := users[name]
+		userData := UserDataSourceModel{
+			Name:                   types.StringValue(name),
+			Seed:                   u.Seed,
+			AccountSeed:            data.AccountSeed,
+			IssuerAccount:          data.IssuerAccount,
+			IssuedAt:               data.IssuedAt,
+			Expires:                u.Expires,
+			Permissions:            u.Permissions,
+			SubjectNamespace:       u.SubjectNamespace,
+			Limits:                 u.Limits,
+			BearerToken:            u.BearerToken,
+			AllowedConnectionTypes: u.AllowedConnectionTypes,
+			SourceNetworks:         u.SourceNetworks,
+			Tags:                   u.Tags,
+		}
+
+		// Build into a scratch response so diagnostics can name the user.
+		userResp := &datasource.ReadResponse{}
+		claims, userPub, err := buildUserClaims(ctx, &userData, d.issuedAtRoundTo, userResp)
+		appendUserDiagnostics(name, userResp.Diagnostics, &resp.Diagnostics)
+		if err != nil || resp.Diagnostics.HasError() {
+			return
+		}
+
+		jwtString, err := encodeDeterministic(claims, accountKP)
+		if err != nil {
+			resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode JWT for user %q: %s", name, err))
+			return
+		}
+
+		d.checkJWTSize(fmt.Sprintf("user %q", name), jwtString, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		credsBytes, err := natsjwt.FormatUserConfig(jwtString, []byte(u.Seed.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError("Credentials Encoding Error", fmt.Sprintf("Failed to encode credentials for user %q: %s", name, err))
+			return
+		}
+
+		publicKeys[name] = userPub
+		jwts[name] = jwtString
+		creds[name] = string(credsBytes)
+	}
+
+	var diags diag.Diagnostics
+	data.PublicKeys, diags = types.MapValueFrom(ctx, types.StringType, publicKeys)
+	resp.Diagnostics.Append(diags...)
+	data.JWTs, diags = types.MapValueFrom(ctx, types.StringType, jwts)
+	resp.Diagnostics.Append(diags...)
+	data.Creds, diags = types.MapValueFrom(ctx, types.StringType, creds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// appendUserDiagnostics copies diagnostics raised while building one user's
+// claims, prefixing each detail with the user name.
+func appendUserDiagnostics(name string, from diag.Diagnostics, to *diag.Diagnostics) {
+	for _, d := range from {
+		detail := fmt.Sprintf("User %q: %s", name, d.Detail())
+		if d.Severity() == diag.SeverityError {
+			to.AddError(d.Summary(), detail)
+		} else {
+			to.AddWarning(d.Summary(), detail)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccAccountCredsDataSource_Basic(t *testing.T) {
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	aliceSeed, alicePub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+	bobSeed, bobPub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account_creds" "test" {
+  account_seed = %q
+  users = {
+    alice = {
+      seed              = %q
+      subject_namespace = "alice"
+    }
+    bob = {
+      seed = %q
+      permissions = {
+        pub_allow = ["orders.>"]
+      }
+    }
+  }
+}
+`, acctSeed, aliceSeed, bobSeed)
+
+	checkCreds := func(name, userSeed, wantPub string) resource.TestCheckFunc {
+		return resource.TestCheckResourceAttrWith("data.natsjwt_account_creds.test", "creds."+name, func(creds string) error {
+			jwtString, err := natsjwt.ParseDecoratedJWT([]byte(creds))
+			if err != nil {
+				return fmt.Errorf("failed to parse JWT from %s creds: %w", name, err)
+			}
+			claims, err := natsjwt.DecodeUserClaims(jwtString)
+			if err != nil {
+				return fmt.Errorf("failed to decode %s JWT: %w", name, err)
+			}
+			if claims.Issuer != acctPub {
+				return fmt.Errorf("expected %s to be issued by %s, got %s", name, acctPub, claims.Issuer)
+			}
+			if claims.Name != name {
+				return fmt.Errorf("expected name %q, got %q", name, claims.Name)
+			}
+			if len(claims.Pub.Allow) != 1 || claims.Pub.Allow[0] != wantPub {
+				return fmt.Errorf("expected %s pub allow [%s], got %v", name, wantPub, claims.Pub.Allow)
+			}
+			kp, err := natsjwt.ParseDecoratedUserNKey([]byte(creds))
+			if err != nil {
+				return fmt.Errorf("failed to parse seed from %s creds: %w", name, err)
+			}
+			seed, err := kp.Seed()
+			if err != nil {
+				return err
+			}
+			if string(seed) != userSeed {
+				return fmt.Errorf("%s creds carry the wrong seed", name)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkCreds("alice", aliceSeed, "alice.>"),
+					checkCreds("bob", bobSeed, "orders.>"),
+					resource.TestCheckResourceAttr("data.natsjwt_account_creds.test", "public_keys.alice", alicePub),
+					resource.TestCheckResourceAttr("data.natsjwt_account_creds.test", "public_keys.bob", bobPub),
+					resource.TestCheckResourceAttrSet("data.natsjwt_account_creds.test", "jwts.alice"),
+					resource.TestCheckResourceAttrSet("data.natsjwt_account_creds.test", "jwts.bob"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountCredsDataSource_InvalidName(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_account_creds" "test" {
+  account_seed = %q
+  users = {
+    " alice" = {
+      seed = %q
+    }
+  }
+}
+`, testAccountSeed(t), testUserSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Name`),
+			},
+		},
+	})
+}
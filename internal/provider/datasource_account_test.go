@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	natsjwt "github.com/nats-io/jwt/v2"
 	"github.com/nats-io/nkeys"
@@ -172,6 +176,99 @@ data "natsjwt_account" "test" {
 	})
 }
 
+func TestAccAccountDataSource_JetStreamDuplicateTier(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "dup-tier-acct"
+  seed          = %q
+  operator_seed = %q
+  jetstream_limits = [
+    {
+      tier        = "R3"
+      mem_storage = 1073741824
+    },
+    {
+      tier        = "R3"
+      mem_storage = 2147483648
+    }
+  ]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Duplicate JetStream Tier`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_JetStreamInvalidTier(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "bad-tier-acct"
+  seed          = %q
+  operator_seed = %q
+  jetstream_limits = [{
+    tier        = "X1"
+    mem_storage = 1073741824
+  }]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid JetStream Tier`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_JetStreamGlobalAndTiered(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "mixed-tier-acct"
+  seed          = %q
+  operator_seed = %q
+  jetstream_limits = [
+    {
+      mem_storage  = 4294967296
+      disk_storage = 8589934592
+    },
+    {
+      tier        = "R3"
+      mem_storage = 1073741824
+    }
+  ]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Conflicting JetStream Limits`),
+			},
+		},
+	})
+}
+
 func TestAccAccountDataSource_DefaultPermissions(t *testing.T) {
 	opSeed := testOperatorSeed(t)
 	acctSeed := testAccountSeed(t)
@@ -225,6 +322,195 @@ data "natsjwt_account" "test" {
 	})
 }
 
+func TestAccAccountDataSource_ExportRevocations(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	_, importerPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "export-acct"
+  seed          = %q
+  operator_seed = %q
+  exports = [{
+    name      = "orders"
+    subject   = "orders.>"
+    type      = "stream"
+    token_req = true
+    revocations = {
+      %q = 1700000000
+    }
+  }]
+}
+`, acctSeed, opSeed, importerPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if len(claims.Exports) != 1 {
+							return fmt.Errorf("expected 1 export, got %d", len(claims.Exports))
+						}
+						exp := claims.Exports[0]
+						if exp.Subject != "orders.>" || exp.Type != natsjwt.Stream {
+							return fmt.Errorf("export mismatch: %+v", exp)
+						}
+						if ts, ok := exp.Revocations[importerPub]; !ok || ts != 1700000000 {
+							return fmt.Errorf("expected revocation for %s at 1700000000, got %v", importerPub, exp.Revocations)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ExportRevocationsWildcard(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "export-acct"
+  seed          = %q
+  operator_seed = %q
+  exports = [{
+    subject   = "orders.>"
+    type      = "stream"
+    token_req = true
+    revocations = {
+      "*" = 1700000000
+    }
+  }]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if ts, ok := claims.Exports[0].Revocations[natsjwt.All]; !ok || ts != 1700000000 {
+							return fmt.Errorf("expected wildcard revocation at 1700000000, got %v", claims.Exports[0].Revocations)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ExportRevocationsWrongKeyType(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	_, userPub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "export-acct"
+  seed          = %q
+  operator_seed = %q
+  exports = [{
+    subject = "orders.>"
+    type    = "stream"
+    revocations = {
+      %q = 1700000000
+    }
+  }]
+}
+`, acctSeed, opSeed, userPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Wrong NKey Public Key Type`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ImportFrom(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	_, exporterPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "importer-acct"
+  seed          = %q
+  operator_seed = %q
+  import_from = {
+    %q = ["orders.>"]
+  }
+}
+`, acctSeed, opSeed, exporterPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if len(claims.Imports) != 1 {
+							return fmt.Errorf("expected 1 import, got %d", len(claims.Imports))
+						}
+						imp := claims.Imports[0]
+						if imp.Account != exporterPub || imp.Subject != "orders.>" || imp.Type != natsjwt.Service {
+							return fmt.Errorf("import mismatch: %+v", imp)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ImportFromWildcard(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "importer-acct"
+  seed          = %q
+  operator_seed = %q
+  import_from = {
+    "*" = ["orders.>"]
+  }
+}
+`, testAccountSeed(t), testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Wildcard Public Key Not Allowed`),
+			},
+		},
+	})
+}
+
 func TestAccAccountDataSource_WrongSeedType(t *testing.T) {
 	opSeed := testOperatorSeed(t)
 	userKP, _ := nkeys.CreatePair(nkeys.PrefixByteUser)
@@ -276,3 +562,1248 @@ data "natsjwt_account" "test" {
 		},
 	})
 }
+
+func TestAccAccountDataSource_ExportTokenReqAdvertise(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "export-acct"
+  seed          = %q
+  operator_seed = %q
+  exports = [{
+    subject   = "orders.>"
+    type      = "stream"
+    token_req = true
+    advertise = true
+  }, {
+    subject = "public.>"
+    type    = "stream"
+  }]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "warnings.#", "1"),
+					resource.TestMatchResourceAttr("data.natsjwt_account.test", "warnings.0", regexp.MustCompile(`"orders.>" requires activation tokens`)),
+					testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if len(claims.Exports) != 2 {
+							return fmt.Errorf("expected 2 exports, got %d", len(claims.Exports))
+						}
+						if !claims.Exports[0].TokenReq || !claims.Exports[0].Advertise {
+							return fmt.Errorf("expected first export to require tokens and be advertised: %+v", claims.Exports[0])
+						}
+						if claims.Exports[1].TokenReq || claims.Exports[1].Advertise {
+							return fmt.Errorf("expected second export to be public and not advertised: %+v", claims.Exports[1])
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ExportAccountTokenPosition(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "export-acct"
+  seed          = %q
+  operator_seed = %q
+  exports = [{
+    subject                = "foo.*.baz"
+    type                   = "service"
+    account_token_position = 2
+  }]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if len(claims.Exports) != 1 || claims.Exports[0].AccountTokenPosition != 2 {
+							return fmt.Errorf("expected account token position 2, got %+v", claims.Exports)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ExportAccountTokenPositionNoWildcard(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "export-acct"
+  seed          = %q
+  operator_seed = %q
+  exports = [{
+    subject                = "foo.bar.baz"
+    type                   = "service"
+    account_token_position = 2
+  }]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Account Token Position`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ExportAccountTokenWildcard(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "export-acct"
+  seed          = %q
+  operator_seed = %q
+  exports = [
+    {
+      subject                = "ACCOUNT.events"
+      type                   = "stream"
+      account_token_wildcard = "ACCOUNT"
+    },
+    {
+      subject                = "billing.ACCOUNT.invoices"
+      type                   = "service"
+      account_token_wildcard = "ACCOUNT"
+    },
+    {
+      subject                = "svc.v1.TENANT.>"
+      type                   = "service"
+      account_token_wildcard = "TENANT"
+    }
+  ]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+					claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+					if err != nil {
+						return fmt.Errorf("failed to decode account JWT: %w", err)
+					}
+					want := map[string]uint{
+						"*.events":           1,
+						"billing.*.invoices": 2,
+						"svc.v1.*.>":         3,
+					}
+					if len(claims.Exports) != len(want) {
+						return fmt.Errorf("expected %d exports, got %d", len(want), len(claims.Exports))
+					}
+					for _, exp := range claims.Exports {
+						pos, ok := want[string(exp.Subject)]
+						if !ok {
+							return fmt.Errorf("unexpected export subject %q", exp.Subject)
+						}
+						if exp.AccountTokenPosition != pos {
+							return fmt.Errorf("export %q: expected account token position %d, got %d", exp.Subject, pos, exp.AccountTokenPosition)
+						}
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestExportAccountToken_Errors(t *testing.T) {
+	tests := map[string]ExportModel{
+		"placeholder missing": {
+			Subject:              types.StringValue("orders.created"),
+			AccountTokenWildcard: types.StringValue("ACCOUNT"),
+			AccountTokenPosition: types.Int64Null(),
+		},
+		"placeholder repeated": {
+			Subject:              types.StringValue("ACCOUNT.orders.ACCOUNT"),
+			AccountTokenWildcard: types.StringValue("ACCOUNT"),
+			AccountTokenPosition: types.Int64Null(),
+		},
+		"placeholder not a token": {
+			Subject:              types.StringValue("orders.*"),
+			AccountTokenWildcard: types.StringValue("*"),
+			AccountTokenPosition: types.Int64Null(),
+		},
+		"both set": {
+			Subject:              types.StringValue("orders.ACCOUNT"),
+			AccountTokenWildcard: types.StringValue("ACCOUNT"),
+			AccountTokenPosition: types.Int64Value(2),
+		},
+	}
+
+	for name, e := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := exportAccountToken(e); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestAccAccountDataSource_MaxJWTSize(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	subjects := make([]string, 0, 5000)
+	for i := 0; i < 5000; i++ {
+		subjects = append(subjects, fmt.Sprintf("%q", fmt.Sprintf("tenant.%d.>", i)))
+	}
+
+	account := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "big-acct"
+  seed          = %q
+  operator_seed = %q
+  default_permissions = {
+    pub_allow = [%s]
+  }
+}
+`, acctSeed, opSeed, strings.Join(subjects, ", "))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "natsjwt" {
+  max_jwt_size = 65536
+}
+` + account,
+				ExpectError: regexp.MustCompile(`JWT Too Large`),
+			},
+			{
+				Config: account,
+				Check:  resource.TestCheckResourceAttrSet("data.natsjwt_account.test", "jwt"),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ExtraClaims(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "extra-acct"
+  seed          = %q
+  operator_seed = %q
+  extra_claims  = jsonencode({ x_feature = { enabled = true } })
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+						// Decoding verifies the signature over the merged payload.
+						if _, err := natsjwt.DecodeAccountClaims(jwtStr); err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						payload, err := base64.RawURLEncoding.DecodeString(strings.Split(jwtStr, ".")[1])
+						if err != nil {
+							return err
+						}
+						var fields map[string]interface{}
+						if err := json.Unmarshal(payload, &fields); err != nil {
+							return err
+						}
+						feature, ok := fields["x_feature"].(map[string]interface{})
+						if !ok || feature["enabled"] != true {
+							return fmt.Errorf("expected x_feature.enabled in payload, got %v", fields["x_feature"])
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ExtraClaimsProtectedField(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "extra-acct"
+  seed          = %q
+  operator_seed = %q
+  extra_claims  = jsonencode({ iss = "OSOMEONEELSE" })
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Extra Claims`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_JetStreamEnabled(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "enabled" {
+  name              = "js"
+  seed              = %[1]q
+  operator_seed     = %[2]q
+  jetstream_enabled = true
+}
+
+data "natsjwt_account" "explicit" {
+  name              = "js"
+  seed              = %[1]q
+  operator_seed     = %[2]q
+  jetstream_enabled = true
+  jetstream_limits = [{
+    mem_storage  = 1024
+    disk_storage = 2048
+  }]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_account.enabled", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						js := claims.Limits.JetStreamLimits
+						if !claims.Limits.IsJSEnabled() {
+							return fmt.Errorf("expected JetStream to be enabled")
+						}
+						if js.MemoryStorage != -1 || js.DiskStorage != -1 || js.Streams != -1 || js.Consumer != -1 {
+							return fmt.Errorf("expected unlimited JetStream limits, got %+v", js)
+						}
+						return nil
+					}),
+					testCheckJWTField("data.natsjwt_account.explicit", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						js := claims.Limits.JetStreamLimits
+						if js.MemoryStorage != 1024 || js.DiskStorage != 2048 {
+							return fmt.Errorf("expected explicit jetstream_limits to win, got %+v", js)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_JetStreamMaxStreamBytesUnlimited(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "js-acct"
+  seed          = %q
+  operator_seed = %q
+  jetstream_limits = [
+    {
+      tier        = "R1"
+      mem_storage = 1024
+    },
+    {
+      tier                 = "R3"
+      mem_storage          = 1024
+      mem_max_stream_bytes = 0
+    },
+    {
+      tier                           = "R5"
+      mem_storage                    = 1024
+      mem_max_stream_bytes_unlimited = true
+    },
+    {
+      tier                 = "R7"
+      mem_storage          = 1024
+      mem_max_stream_bytes = 512
+    },
+  ]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						tiers := claims.Limits.JetStreamTieredLimits
+						// Unset, 0 and the unlimited flag all mean "no per-stream limit" to the jwt library.
+						for _, tier := range []string{"R1", "R3", "R5"} {
+							if got := tiers[tier].MemoryMaxStreamBytes; got != 0 {
+								return fmt.Errorf("expected unlimited (0) mem_max_stream_bytes for %s, got %d", tier, got)
+							}
+							if got := tiers[tier].MemoryStorage; got != 1024 {
+								return fmt.Errorf("expected mem_storage 1024 for %s, got %d", tier, got)
+							}
+						}
+						if got := tiers["R7"].MemoryMaxStreamBytes; got != 512 {
+							return fmt.Errorf("expected mem_max_stream_bytes 512 for R7, got %d", got)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_JetStreamMaxStreamBytesUnlimitedConflict(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "js-acct"
+  seed          = %q
+  operator_seed = %q
+  jetstream_limits = [{
+    mem_storage                    = 1024
+    mem_max_stream_bytes           = 512
+    mem_max_stream_bytes_unlimited = true
+  }]
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Conflicting JetStream Limits`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_EffectiveLimits(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "defaults" {
+  name          = "defaults-acct"
+  seed          = %[1]q
+  operator_seed = %[2]q
+}
+
+data "natsjwt_account" "limited" {
+  name          = "limited-acct"
+  seed          = %[1]q
+  operator_seed = %[2]q
+  nats_limits = {
+    subs = 100
+  }
+  account_limits = {
+    conn = 10
+  }
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_account.defaults", "effective_limits.subs", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.defaults", "effective_limits.conn", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.limited", "effective_limits.subs", "100"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.limited", "effective_limits.data", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.limited", "effective_limits.conn", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_AppliedFeatures(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "none" {
+  name          = "plain"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_account" "nats_only" {
+  name          = "nats-only"
+  seed          = %q
+  operator_seed = %q
+  nats_limits = {
+    subs = 100
+  }
+}
+`, acctSeed, opSeed, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_account.none", "applied_features.#", "0"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.nats_only", "applied_features.#", "1"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.nats_only", "applied_features.0", "nats_limits"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_StrictSigningKeyUsage(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	signingSeed, signingPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	otherSeed, otherPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	acctSeed := testAccountSeed(t)
+
+	config := func(issuer string) string {
+		return fmt.Sprintf(`
+data "natsjwt_operator" "op" {
+  name                     = "strict-op"
+  seed                     = %q
+  signing_keys             = [%q]
+  strict_signing_key_usage = true
+}
+
+data "natsjwt_account" "test" {
+  name          = "strict-acct"
+  seed          = %q
+  operator_seed = %q
+  operator_jwt  = data.natsjwt_operator.op.jwt
+}
+`, opSeed, signingPub, acctSeed, issuer)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(opSeed),
+				ExpectError: regexp.MustCompile(`Signing Key Required`),
+			},
+			{
+				// An operator seed that is not one of the operator's signing keys
+				Config:      config(otherSeed),
+				ExpectError: regexp.MustCompile(`(?s)Signing Key Required.*` + otherPub),
+			},
+			{
+				Config: config(signingSeed),
+				Check: testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+					claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+					if err != nil {
+						return fmt.Errorf("failed to decode account JWT: %w", err)
+					}
+					if claims.Issuer != signingPub {
+						return fmt.Errorf("expected issuer %s, got %s", signingPub, claims.Issuer)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_StrictSigningKeyUsageWarning(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	_, signingPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "op" {
+  name                     = "strict-op"
+  seed                     = %q
+  signing_keys             = [%q]
+  strict_signing_key_usage = true
+}
+
+data "natsjwt_account" "test" {
+  name                 = "root-signed"
+  seed                 = %q
+  operator_seed        = %q
+  operator_jwt         = data.natsjwt_operator.op.jwt
+  enforce_signing_keys = false
+}
+`, opSeed, signingPub, testAccountSeed(t), opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.natsjwt_account.test", "jwt"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "warnings.#", "1"),
+					resource.TestMatchResourceAttr("data.natsjwt_account.test", "warnings.0", regexp.MustCompile(`signed with the operator identity key`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_AllowAccountSigning(t *testing.T) {
+	parentSeed, parentPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	childSeed := testAccountSeed(t)
+
+	config := func(allow bool) string {
+		return fmt.Sprintf(`
+data "natsjwt_account" "child" {
+  name                  = "child"
+  seed                  = %q
+  operator_seed         = %q
+  allow_account_signing = %t
+}
+`, childSeed, parentSeed, allow)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(false),
+				ExpectError: regexp.MustCompile(`Wrong NKey Seed Type`),
+			},
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_account.child", "chain.issuer", parentPub),
+					testCheckJWTField("data.natsjwt_account.child", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if claims.Issuer != parentPub {
+							return fmt.Errorf("expected issuer %s, got %s", parentPub, claims.Issuer)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_Chain(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	opPub, err := publicKeyFromSeed(opSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingSeed, signingPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	acctSeed := testAccountSeed(t)
+	acctPub, err := publicKeyFromSeed(acctSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(issuer string) string {
+		return fmt.Sprintf(`
+data "natsjwt_operator" "op" {
+  name         = "chain-op"
+  seed         = %q
+  signing_keys = [%q]
+}
+
+data "natsjwt_account" "test" {
+  name          = "chain-acct"
+  seed          = %q
+  operator_seed = %q
+  operator_jwt  = data.natsjwt_operator.op.jwt
+}
+`, opSeed, signingPub, acctSeed, issuer)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(opSeed),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "chain.subject", acctPub),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "chain.issuer", opPub),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "chain.operator", opPub),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "chain.signed_by_root", "true"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "signed_by_signing_key", "false"),
+				),
+			},
+			{
+				Config: config(signingSeed),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "chain.issuer", signingPub),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "chain.operator", opPub),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "chain.signed_by_root", "false"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "signed_by_signing_key", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ChainWithoutOperatorJWT(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "chain-acct"
+  seed          = %q
+  operator_seed = %q
+}
+`, testAccountSeed(t), testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.natsjwt_account.test", "chain.issuer", regexp.MustCompile(`^O`)),
+					resource.TestCheckNoResourceAttr("data.natsjwt_account.test", "chain.operator"),
+					resource.TestCheckNoResourceAttr("data.natsjwt_account.test", "chain.signed_by_root"),
+					resource.TestCheckNoResourceAttr("data.natsjwt_account.test", "signed_by_signing_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_DisallowBearerWarning(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "no-bearer"
+  seed          = %q
+  operator_seed = %q
+  account_limits = {
+    disallow_bearer = true
+  }
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "warnings.#", "1"),
+					resource.TestMatchResourceAttr("data.natsjwt_account.test", "warnings.0", regexp.MustCompile(`bearer_token = true will be rejected`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ZeroConnWarning(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "no-conn"
+  seed          = %q
+  operator_seed = %q
+  account_limits = {
+    conn = 0
+  }
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "effective_limits.conn", "0"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "warnings.#", "1"),
+					resource.TestMatchResourceAttr("data.natsjwt_account.test", "warnings.0", regexp.MustCompile(`connections disabled`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_IssuedAtRFC3339(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name              = "commit-time"
+  seed              = %q
+  operator_seed     = %q
+  issued_at_rfc3339 = "2023-01-01T00:00:00Z"
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+					claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+					if err != nil {
+						return fmt.Errorf("failed to decode account JWT: %w", err)
+					}
+					if claims.IssuedAt != 1672531200 {
+						return fmt.Errorf("expected issued_at 1672531200, got %d", claims.IssuedAt)
+					}
+					if claims.NotBefore != 1672531200 {
+						return fmt.Errorf("expected not_before to default to issued_at, got %d", claims.NotBefore)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_IssuedAtConflict(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name              = "both"
+  seed              = %q
+  operator_seed     = %q
+  issued_at         = 100
+  issued_at_rfc3339 = "2023-01-01T00:00:00Z"
+}
+`, testAccountSeed(t), testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Conflicting Issued At`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_InvalidName(t *testing.T) {
+	config := func(name string) string {
+		return fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = %q
+  seed          = %q
+  operator_seed = %q
+}
+`, name, testAccountSeed(t), testOperatorSeed(t))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(""),
+				ExpectError: regexp.MustCompile(`Name must not be empty`),
+			},
+			{
+				Config:      config(strings.Repeat("a", 1000)),
+				ExpectError: regexp.MustCompile(`Name must be at most 256 characters`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_SigningKeyOrder(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	_, sk1 := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, sk2 := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := func(first, second string) string {
+		return fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "ordered-acct"
+  seed          = %q
+  operator_seed = %q
+  signing_keys  = [%q, %q]
+}
+`, acctSeed, opSeed, first, second)
+	}
+
+	var firstJWT string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(sk1, sk2),
+				Check:  captureJWT("data.natsjwt_account.test", &firstJWT),
+			},
+			{
+				Config: config(sk2, sk1),
+				Check:  compareJWT("data.natsjwt_account.test", &firstJWT),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ScopedSigningKey(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	_, scopedPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "scoped-acct"
+  seed          = %q
+  operator_seed = %q
+  scoped_signing_keys = [{
+    key  = %q
+    role = "service"
+    template = {
+      pub_allow                = ["svc.>"]
+      sub_allow                = ["_INBOX.>"]
+      allowed_connection_types = ["STANDARD"]
+      source_networks          = ["10.0.0.0/8"]
+      locale                   = "Europe/Warsaw"
+    }
+  }]
+}
+`, acctSeed, opSeed, scopedPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+					claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+					if err != nil {
+						return fmt.Errorf("failed to decode account JWT: %w", err)
+					}
+					s, ok := claims.SigningKeys.GetScope(scopedPub)
+					if !ok || s == nil {
+						return fmt.Errorf("expected scoped signing key %s, got %v", scopedPub, claims.SigningKeys.Keys())
+					}
+					scope, ok := s.(*natsjwt.UserScope)
+					if !ok {
+						return fmt.Errorf("expected user scope, got %T", s)
+					}
+					if scope.Role != "service" {
+						return fmt.Errorf("expected role 'service', got %q", scope.Role)
+					}
+					tmpl := scope.Template
+					if len(tmpl.AllowedConnectionTypes) != 1 || !tmpl.AllowedConnectionTypes.Contains("STANDARD") {
+						return fmt.Errorf("expected allowed_connection_types [STANDARD], got %v", tmpl.AllowedConnectionTypes)
+					}
+					if len(tmpl.Src) != 1 || tmpl.Src[0] != "10.0.0.0/8" {
+						return fmt.Errorf("expected source network 10.0.0.0/8, got %v", tmpl.Src)
+					}
+					if tmpl.Locale != "Europe/Warsaw" {
+						return fmt.Errorf("expected locale Europe/Warsaw, got %q", tmpl.Locale)
+					}
+					if !tmpl.Pub.Allow.Contains("svc.>") || !tmpl.Sub.Allow.Contains("_INBOX.>") {
+						return fmt.Errorf("unexpected template permissions: pub=%v sub=%v", tmpl.Pub.Allow, tmpl.Sub.Allow)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ScopedSigningKeyDuplicate(t *testing.T) {
+	_, scopedPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name                = "dup-scoped-acct"
+  seed                = %q
+  operator_seed       = %q
+  signing_keys        = [%q]
+  scoped_signing_keys = [{ key = %q }]
+}
+`, testAccountSeed(t), testOperatorSeed(t), scopedPub, scopedPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Duplicate Signing Key`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_ExplicitZeroLimits(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "zero-limits"
+  seed          = %q
+  operator_seed = %q
+  nats_limits = {
+    payload = 0
+  }
+  account_limits = {
+    conn = 0
+  }
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "effective_limits.payload", "0"),
+					resource.TestCheckResourceAttr("data.natsjwt_account.test", "effective_limits.data", "-1"),
+					testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if claims.Limits.Payload != 0 {
+							return fmt.Errorf("expected payload 0, got %d", claims.Limits.Payload)
+						}
+						if claims.Limits.Conn != 0 {
+							return fmt.Errorf("expected conn 0, got %d", claims.Limits.Conn)
+						}
+						if claims.Limits.Subs != -1 || claims.Limits.Data != -1 || claims.Limits.Imports != -1 || claims.Limits.LeafNodeConn != -1 {
+							return fmt.Errorf("expected unset limits to be -1, got %+v", claims.Limits)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_NormalizeSubjects(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	_, exporterPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := func(exportSubject, importSubject string) string {
+		return fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name               = "normalized"
+  seed               = %q
+  operator_seed      = %q
+  normalize_subjects = true
+  exports = [{
+    subject = %q
+    type    = "stream"
+  }]
+  import_from = {
+    %q = [%q]
+  }
+}
+`, acctSeed, opSeed, exportSubject, exporterPub, importSubject)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(" orders.> ", "billing.invoices "),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if got := string(claims.Exports[0].Subject); got != "orders.>" {
+							return fmt.Errorf("expected export subject %q, got %q", "orders.>", got)
+						}
+						if got := string(claims.Imports[0].Subject); got != "billing.invoices" {
+							return fmt.Errorf("expected import subject %q, got %q", "billing.invoices", got)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config:      config("orders.", "billing.invoices"),
+				ExpectError: regexp.MustCompile(`Invalid Subject`),
+			},
+			{
+				Config:      config("orders.>", "billing..invoices"),
+				ExpectError: regexp.MustCompile(`Invalid Subject`),
+			},
+		},
+	})
+}
+
+func TestAccAccountDataSource_OmitEmptyFields(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := func(omitEmpty string) string {
+		return fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name              = "plain-acct"
+  seed              = %q
+  operator_seed     = %q
+  omit_empty_fields = %s
+}
+`, acctSeed, opSeed, omitEmpty)
+	}
+
+	// checkLimits reports whether the JetStream fields, which are zero for an
+	// account without limits, appear in the limits object of the payload.
+	checkLimits := func(wantZeroFields bool) resource.TestCheckFunc {
+		return testCheckJWTField("data.natsjwt_account.test", func(jwtStr string) error {
+			claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+			if err != nil {
+				return fmt.Errorf("failed to decode account JWT: %w", err)
+			}
+			if claims.Limits.IsJSEnabled() {
+				return fmt.Errorf("expected JetStream to stay disabled, got %+v", claims.Limits.JetStreamLimits)
+			}
+			payload, err := base64.RawURLEncoding.DecodeString(strings.Split(jwtStr, ".")[1])
+			if err != nil {
+				return err
+			}
+			var fields struct {
+				Nats struct {
+					Limits map[string]interface{} `json:"limits"`
+				} `json:"nats"`
+			}
+			if err := json.Unmarshal(payload, &fields); err != nil {
+				return err
+			}
+			value, ok := fields.Nats.Limits["mem_storage"]
+			if ok != wantZeroFields {
+				return fmt.Errorf("expected mem_storage present=%t, got limits %v", wantZeroFields, fields.Nats.Limits)
+			}
+			if ok && value != float64(0) {
+				return fmt.Errorf("expected mem_storage 0, got %v", value)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("true"),
+				Check:  checkLimits(false),
+			},
+			{
+				Config: config("false"),
+				Check:  checkLimits(true),
+			},
+		},
+	})
+}
+
+// Account signing keys are a map in the jwt library, which marshals them in
+// sorted order. This test pins that behavior rather than any sorting of ours.
+func TestAccAccountDataSource_SigningKeysOrderStable(t *testing.T) {
+	opSeed, opPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	acctSeed := testAccountSeed(t)
+	_, sk1 := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, sk2 := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, sk3 := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "a" {
+  name          = "ordered"
+  seed          = %[1]q
+  operator_seed = %[2]q
+  signing_keys  = [%[3]q, %[4]q, %[5]q]
+}
+
+data "natsjwt_account" "b" {
+  name          = "ordered"
+  seed          = %[1]q
+  operator_seed = %[2]q
+  signing_keys  = [%[5]q, %[3]q, %[4]q]
+}
+`, acctSeed, opSeed, sk1, sk2, sk3)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.natsjwt_account.a", "jwt", "data.natsjwt_account.b", "jwt"),
+					testCheckJWTField("data.natsjwt_account.b", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if claims.Issuer != opPub {
+							return fmt.Errorf("expected issuer %s, got %s", opPub, claims.Issuer)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -3,10 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	natsjwt "github.com/nats-io/jwt/v2"
 )
@@ -20,11 +24,42 @@ type ConfigHelperDataSourceModel struct {
 	AccountJWTs      types.List   `tfsdk:"account_jwts"`
 	SystemAccountJWT types.String `tfsdk:"system_account_jwt"`
 	ResolverType     types.String `tfsdk:"resolver_type"`
+	OutputMode       types.String `tfsdk:"output_mode"`
+	ServerNames      types.List   `tfsdk:"server_names"`
+	RevokeBeforeUnix types.Int64  `tfsdk:"revoke_before_unix"`
+	StrictSystemAcct types.Bool   `tfsdk:"strict_system_account"`
+	Listen           types.String `tfsdk:"listen"`
+	HTTPPort         types.Int64  `tfsdk:"http_port"`
+	EnableJetStream  types.Bool   `tfsdk:"enable_jetstream"`
+	TLS              types.Object `tfsdk:"tls"`
+	Gateways         types.Object `tfsdk:"gateways"`
 	ServerConfig     types.String `tfsdk:"server_config"`
 	Operator         types.String `tfsdk:"operator"`
+	OperatorTags     types.List   `tfsdk:"operator_tags"`
 	SystemAccount    types.String `tfsdk:"system_account"`
 	Resolver         types.String `tfsdk:"resolver"`
 	ResolverPreload  types.Map    `tfsdk:"resolver_preload"`
+	Files            types.Map    `tfsdk:"files"`
+	ServerConfigs    types.Map    `tfsdk:"server_configs"`
+	Warnings         types.List   `tfsdk:"warnings"`
+}
+
+type ConfigTLSModel struct {
+	CertFile types.String `tfsdk:"cert_file"`
+	KeyFile  types.String `tfsdk:"key_file"`
+	CAFile   types.String `tfsdk:"ca_file"`
+	Verify   types.Bool   `tfsdk:"verify"`
+}
+
+type ConfigGatewaysModel struct {
+	Name    types.String `tfsdk:"name"`
+	Port    types.Int64  `tfsdk:"port"`
+	Remotes types.List   `tfsdk:"remotes"`
+}
+
+type ConfigGatewayRemoteModel struct {
+	Name types.String `tfsdk:"name"`
+	URL  types.String `tfsdk:"url"`
 }
 
 func NewConfigHelperDataSource() datasource.DataSource {
@@ -56,6 +91,90 @@ func (d *ConfigHelperDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Optional:    true,
 				Description: "Resolver type. Currently only MEMORY is supported.",
 			},
+			"output_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "How to emit the configuration: single (default) puts everything in server_config; split writes the operator and each account into separate files and makes server_config include them; resolver_dir keeps server_config as in single mode and writes each account JWT to a <public-key>.jwt file for a FULL resolver directory.",
+				Validators:  []validator.String{ConfigOutputModeValidator()},
+			},
+			"server_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Server names of an HA cluster sharing this operator. Each gets its own entry in server_configs.",
+			},
+			"revoke_before_unix": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Cutoff Unix timestamp. Account JWTs issued before it are reported in warnings.",
+			},
+			"strict_system_account": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fail when system_account_jwt is set but its subject is not the system_account of the operator JWT. Default false.",
+			},
+			"listen": schema.StringAttribute{
+				Optional:    true,
+				Description: "Client listen address, e.g. 0.0.0.0:4222. Emitted as a listen directive when set.",
+			},
+			"http_port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Monitoring port, e.g. 8222. Emitted as an http_port directive when set.",
+				Validators:  []validator.Int64{PortValidator()},
+			},
+			"enable_jetstream": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Emit a jetstream {} block. When unset, the block is emitted if any preloaded account has JetStream storage limits. Set to false to never emit it.",
+			},
+			"tls": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Client TLS settings. Emitted as a tls block when set.",
+				Attributes: map[string]schema.Attribute{
+					"cert_file": schema.StringAttribute{
+						Required:    true,
+						Description: "Path to the server certificate.",
+					},
+					"key_file": schema.StringAttribute{
+						Required:    true,
+						Description: "Path to the server private key.",
+					},
+					"ca_file": schema.StringAttribute{
+						Optional:    true,
+						Description: "Path to the CA certificate used to verify clients.",
+					},
+					"verify": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Require and verify client certificates. Default false.",
+					},
+				},
+			},
+			"gateways": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Supercluster gateway settings. Emitted as a gateway block when set.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "Name of this cluster's gateway.",
+					},
+					"port": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Port this gateway listens on for other clusters, e.g. 7222.",
+						Validators:  []validator.Int64{PortValidator()},
+					},
+					"remotes": schema.ListNestedAttribute{
+						Optional:    true,
+						Description: "Remote gateways to connect to.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Required:    true,
+									Description: "Gateway name of the remote cluster.",
+								},
+								"url": schema.StringAttribute{
+									Required:    true,
+									Description: "URL of the remote gateway, e.g. nats://east.example.com:7222.",
+								},
+							},
+						},
+					},
+				},
+			},
 			"server_config": schema.StringAttribute{
 				Computed:    true,
 				Description: "Complete NATS server configuration snippet.",
@@ -64,6 +183,11 @@ func (d *ConfigHelperDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:    true,
 				Description: "The operator JWT value for the config.",
 			},
+			"operator_tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Tags of the operator JWT, e.g. env:prod. Empty when the operator has no tags.",
+			},
 			"system_account": schema.StringAttribute{
 				Computed:    true,
 				Description: "The system account public key.",
@@ -77,6 +201,21 @@ func (d *ConfigHelperDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:    true,
 				Description: "Map of account public keys to their JWTs.",
 			},
+			"files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of file names to contents. In split mode the files are relative to the directory of the main config; in resolver_dir mode they form the resolver directory. Only set when output_mode is split or resolver_dir.",
+			},
+			"server_configs": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of server name to server_config prefixed with a server_name line. Only set when server_names is configured.",
+			},
+			"warnings": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Advisory findings about the inputs, such as account JWTs issued before revoke_before_unix or account keys given more than one differing JWT.",
+			},
 		},
 	}
 }
@@ -88,64 +227,293 @@ func (d *ConfigHelperDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	resolverType := "MEMORY"
-	if !data.ResolverType.IsNull() {
-		resolverType = data.ResolverType.ValueString()
-		if resolverType != "MEMORY" {
-			resp.Diagnostics.AddError("Unsupported Resolver Type",
-				fmt.Sprintf("Only MEMORY resolver is currently supported, got: %s", resolverType))
+	var accountJWTs []string
+	if !data.AccountJWTs.IsNull() {
+		resp.Diagnostics.Append(data.AccountJWTs.ElementsAs(ctx, &accountJWTs, false)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
-	operatorJWT := data.OperatorJWT.ValueString()
+	cfg, diags := buildServerConfig(data.OperatorJWT.ValueString(), data.SystemAccountJWT.ValueString(), accountJWTs, data.ResolverType.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.StrictSystemAcct.ValueBool() && cfg.SystemAccount != "" && cfg.SystemAccount != cfg.OperatorSystemAccount {
+		operatorSystemAccount := cfg.OperatorSystemAccount
+		if operatorSystemAccount == "" {
+			operatorSystemAccount = "none"
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("system_account_jwt"), "System Account Mismatch",
+			fmt.Sprintf("system_account_jwt is for account %s, but the operator JWT names %s as its system account", cfg.SystemAccount, operatorSystemAccount))
+		return
+	}
+
+	preloadTF, diags := types.MapValueFrom(ctx, types.StringType, cfg.Preload)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	preload := make(map[string]string)
+	listeners, diags := listenerDirectives(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Decode system account JWT
-	var systemAccountPub string
-	if !data.SystemAccountJWT.IsNull() {
-		sysJWT := data.SystemAccountJWT.ValueString()
-		sysClaims, err := natsjwt.DecodeAccountClaims(sysJWT)
-		if err != nil {
-			resp.Diagnostics.AddError("Invalid System Account JWT",
-				fmt.Sprintf("Failed to decode system account JWT: %s", err))
+	jetStream := len(cfg.JetStreamAccounts) > 0
+	if !data.EnableJetStream.IsNull() {
+		jetStream = data.EnableJetStream.ValueBool()
+	}
+	if jetStream {
+		listeners += jetStreamBlock
+	}
+
+	data.ServerConfig = types.StringValue(listeners + cfg.Config)
+	data.Files = types.MapNull(types.StringType)
+	if data.OutputMode.ValueString() == "split" {
+		config, files := cfg.split()
+		filesTF, diags := types.MapValueFrom(ctx, types.StringType, files)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		systemAccountPub = sysClaims.Subject
-		preload[systemAccountPub] = sysJWT
+		data.ServerConfig = types.StringValue(listeners + config)
+		data.Files = filesTF
+	} else if data.OutputMode.ValueString() == "resolver_dir" {
+		filesTF, diags := types.MapValueFrom(ctx, types.StringType, cfg.resolverDir())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Files = filesTF
 	}
-
-	// Decode account JWTs
-	if !data.AccountJWTs.IsNull() {
-		var accountJWTs []string
-		resp.Diagnostics.Append(data.AccountJWTs.ElementsAs(ctx, &accountJWTs, false)...)
+	data.ServerConfigs = types.MapNull(types.StringType)
+	if !data.ServerNames.IsNull() {
+		var serverNames []string
+		resp.Diagnostics.Append(data.ServerNames.ElementsAs(ctx, &serverNames, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		for _, jwt := range accountJWTs {
-			acctClaims, err := natsjwt.DecodeAccountClaims(jwt)
-			if err != nil {
-				resp.Diagnostics.AddError("Invalid Account JWT",
-					fmt.Sprintf("Failed to decode account JWT: %s", err))
+		serverConfigs := make(map[string]string, len(serverNames))
+		for _, name := range serverNames {
+			if _, ok := serverConfigs[name]; ok {
+				resp.Diagnostics.AddError("Duplicate Server Name", fmt.Sprintf("Server name %q is listed more than once", name))
 				return
 			}
-			preload[acctClaims.Subject] = jwt
+			serverConfigs[name] = fmt.Sprintf("server_name: %s\n", name) + data.ServerConfig.ValueString()
+		}
+		serverConfigsTF, diags := types.MapValueFrom(ctx, types.StringType, serverConfigs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+		data.ServerConfigs = serverConfigsTF
 	}
-
-	// Build resolver_preload map for TF state
-	preloadMap := make(map[string]string)
-	for k, v := range preload {
-		preloadMap[k] = v
+	warnings := append([]string{}, cfg.Warnings...)
+	if sys := cfg.OperatorSystemAccount; sys != "" {
+		if _, ok := cfg.Preload[sys]; !ok {
+			warnings = append(warnings, fmt.Sprintf("operator system account %s is not preloaded; set system_account_jwt or add it to account_jwts so the server can resolve it", sys))
+		}
+		if cfg.SystemAccount != "" && cfg.SystemAccount != sys {
+			warnings = append(warnings, fmt.Sprintf("system_account_jwt is for account %s, but the operator JWT names %s as its system account", cfg.SystemAccount, sys))
+		}
+	}
+	if !jetStream {
+		for _, pub := range cfg.JetStreamAccounts {
+			warnings = append(warnings, fmt.Sprintf("account %s has JetStream limits, but enable_jetstream is false so the server will not run JetStream", pub))
+		}
 	}
+	if !data.RevokeBeforeUnix.IsNull() {
+		cutoff := data.RevokeBeforeUnix.ValueInt64()
+		for _, pub := range sortedKeys(cfg.Preload) {
+			if issuedAt := cfg.IssuedAt[pub]; issuedAt < cutoff {
+				warnings = append(warnings, fmt.Sprintf("account %s was issued at %d, before revoke_before_unix %d", pub, issuedAt, cutoff))
+			}
+		}
+	}
+	warningsTF, diags := types.ListValueFrom(ctx, types.StringType, warnings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Warnings = warningsTF
 
-	preloadTF, diags := types.MapValueFrom(ctx, types.StringType, preloadMap)
+	operatorTagsTF, diags := types.ListValueFrom(ctx, types.StringType, cfg.OperatorTags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	data.Operator = types.StringValue(cfg.Operator)
+	data.OperatorTags = operatorTagsTF
+	data.SystemAccount = types.StringValue(cfg.SystemAccount)
+	data.Resolver = types.StringValue(cfg.Resolver)
+	data.ResolverPreload = preloadTF
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listenerDirectives renders the optional listen, http_port, tls and gateway
+// settings that precede the operator and resolver settings. It returns "" when none are set.
+func listenerDirectives(ctx context.Context, data ConfigHelperDataSourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var sb strings.Builder
+
+	if !data.Listen.IsNull() {
+		sb.WriteString(fmt.Sprintf("listen: %s\n", data.Listen.ValueString()))
+	}
+	if !data.HTTPPort.IsNull() {
+		sb.WriteString(fmt.Sprintf("http_port: %d\n", data.HTTPPort.ValueInt64()))
+	}
+	if !data.TLS.IsNull() {
+		var tls ConfigTLSModel
+		diags.Append(data.TLS.As(ctx, &tls, objectAsOptions)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		sb.WriteString("tls {\n")
+		sb.WriteString(fmt.Sprintf("  cert_file: %q\n", tls.CertFile.ValueString()))
+		sb.WriteString(fmt.Sprintf("  key_file: %q\n", tls.KeyFile.ValueString()))
+		if !tls.CAFile.IsNull() {
+			sb.WriteString(fmt.Sprintf("  ca_file: %q\n", tls.CAFile.ValueString()))
+		}
+		if !tls.Verify.IsNull() {
+			sb.WriteString(fmt.Sprintf("  verify: %t\n", tls.Verify.ValueBool()))
+		}
+		sb.WriteString("}\n")
+	}
+	if !data.Gateways.IsNull() {
+		var gw ConfigGatewaysModel
+		diags.Append(data.Gateways.As(ctx, &gw, objectAsOptions)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		var remotes []ConfigGatewayRemoteModel
+		if !gw.Remotes.IsNull() {
+			diags.Append(gw.Remotes.ElementsAs(ctx, &remotes, false)...)
+			if diags.HasError() {
+				return "", diags
+			}
+		}
+		sb.WriteString("gateway {\n")
+		sb.WriteString(fmt.Sprintf("  name: %q\n", gw.Name.ValueString()))
+		if !gw.Port.IsNull() {
+			sb.WriteString(fmt.Sprintf("  port: %d\n", gw.Port.ValueInt64()))
+		}
+		if len(remotes) > 0 {
+			sb.WriteString("  gateways: [\n")
+			for _, r := range remotes {
+				sb.WriteString(fmt.Sprintf("    {name: %q, url: %q}\n", r.Name.ValueString(), r.URL.ValueString()))
+			}
+			sb.WriteString("  ]\n")
+		}
+		sb.WriteString("}\n")
+	}
+
+	return sb.String(), diags
+}
+
+// serverConfig is the result of assembling a NATS server config from JWTs.
+type serverConfig struct {
+	Config        string
+	Operator      string
+	OperatorTags  []string
+	SystemAccount string
+	// OperatorSystemAccount is the system account named in the operator JWT,
+	// which may differ from SystemAccount.
+	OperatorSystemAccount string
+	Resolver              string
+	Preload               map[string]string
+	IssuedAt              map[string]int64
+	// JetStreamAccounts lists, sorted, the preloaded accounts with JetStream storage limits.
+	JetStreamAccounts []string
+	// Warnings notes account keys that were given more than one differing JWT.
+	Warnings []string
+}
+
+// jetStreamBlock enables JetStream with the server's default storage settings.
+// It is emitted by default when any preloaded account uses JetStream.
+const jetStreamBlock = "jetstream {}\n"
+
+// buildServerConfig assembles the server config shared by the config helper data source
+// and the server_config function. Empty systemAccountJWT and resolverType are treated as unset.
+// Preloaded accounts are emitted sorted by public key so the output is deterministic.
+// For a key given more than once, systemAccountJWT wins for the system account and
+// otherwise the last of accountJWTs wins; differing duplicates are noted in Warnings.
+func buildServerConfig(operatorJWT, systemAccountJWT string, accountJWTs []string, resolverType string) (*serverConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if resolverType == "" {
+		resolverType = "MEMORY"
+	}
+	if resolverType != "MEMORY" {
+		diags.AddError("Unsupported Resolver Type",
+			fmt.Sprintf("Only MEMORY resolver is currently supported, got: %s", resolverType))
+		return nil, diags
+	}
+
+	opClaims, err := natsjwt.DecodeOperatorClaims(operatorJWT)
+	if err != nil {
+		diags.AddError("Invalid Operator JWT",
+			fmt.Sprintf("Failed to decode operator JWT: %s", err))
+		return nil, diags
+	}
+
+	preload := make(map[string]string)
+	issuedAt := make(map[string]int64)
+	jetStream := make(map[string]bool)
+
+	// Decode system account JWT
+	var systemAccountPub string
+	if systemAccountJWT != "" {
+		sysClaims, err := natsjwt.DecodeAccountClaims(systemAccountJWT)
+		if err != nil {
+			diags.AddError("Invalid System Account JWT",
+				fmt.Sprintf("Failed to decode system account JWT: %s", err))
+			return nil, diags
+		}
+		systemAccountPub = sysClaims.Subject
+		preload[systemAccountPub] = systemAccountJWT
+		issuedAt[systemAccountPub] = sysClaims.IssuedAt
+		jetStream[systemAccountPub] = sysClaims.Limits.IsJSEnabled()
+	}
+
+	// Decode account JWTs
+	var warnings []string
+	for _, jwt := range accountJWTs {
+		acctClaims, err := natsjwt.DecodeAccountClaims(jwt)
+		if err != nil {
+			diags.AddError("Invalid Account JWT",
+				fmt.Sprintf("Failed to decode account JWT: %s", err))
+			return nil, diags
+		}
+		pub := acctClaims.Subject
+		if existing, ok := preload[pub]; ok && existing != jwt {
+			if pub == systemAccountPub {
+				warnings = append(warnings, fmt.Sprintf("account_jwts has a different JWT for system account %s; system_account_jwt is used", pub))
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("account_jwts has differing JWTs for account %s; the last one is used", pub))
+		}
+		if pub == systemAccountPub {
+			continue
+		}
+		preload[pub] = jwt
+		issuedAt[pub] = acctClaims.IssuedAt
+		jetStream[pub] = acctClaims.Limits.IsJSEnabled()
+	}
+
+	preloadKeys := sortedKeys(preload)
+	var jetStreamAccounts []string
+	for _, pub := range preloadKeys {
+		if jetStream[pub] {
+			jetStreamAccounts = append(jetStreamAccounts, pub)
+		}
+	}
+
 	// Build server config
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("operator: %s\n", operatorJWT))
@@ -155,17 +523,73 @@ func (d *ConfigHelperDataSource) Read(ctx context.Context, req datasource.ReadRe
 	sb.WriteString(fmt.Sprintf("resolver: %s\n", resolverType))
 	if len(preload) > 0 {
 		sb.WriteString("resolver_preload: {\n")
-		for pub, jwt := range preload {
-			sb.WriteString(fmt.Sprintf("  %s: %s\n", pub, jwt))
+		for _, pub := range preloadKeys {
+			sb.WriteString(fmt.Sprintf("  %s: %s\n", pub, preload[pub]))
 		}
 		sb.WriteString("}\n")
 	}
 
-	data.ServerConfig = types.StringValue(sb.String())
-	data.Operator = types.StringValue(operatorJWT)
-	data.SystemAccount = types.StringValue(systemAccountPub)
-	data.Resolver = types.StringValue(resolverType)
-	data.ResolverPreload = preloadTF
+	return &serverConfig{
+		Config:                sb.String(),
+		Operator:              operatorJWT,
+		OperatorTags:          append([]string{}, opClaims.Tags...),
+		OperatorSystemAccount: opClaims.SystemAccount,
+		SystemAccount:         systemAccountPub,
+		Resolver:              resolverType,
+		Preload:               preload,
+		IssuedAt:              issuedAt,
+		JetStreamAccounts:     jetStreamAccounts,
+		Warnings:              warnings,
+	}, diags
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+// split renders the config as a main file that includes operator.conf and one
+// resolver_preload fragment per account. The system account fragment is named sys.conf.
+// The operator is set in an included file rather than as an operator: file path,
+// because nats-server resolves include paths against the config file's directory but
+// operator paths against its working directory.
+func (c *serverConfig) split() (string, map[string]string) {
+	files := map[string]string{
+		"operator.conf": fmt.Sprintf("operator: %s\n", c.Operator),
+	}
+
+	var sb strings.Builder
+	sb.WriteString("include \"operator.conf\"\n")
+	if c.SystemAccount != "" {
+		sb.WriteString(fmt.Sprintf("system_account: %s\n", c.SystemAccount))
+	}
+	sb.WriteString(fmt.Sprintf("resolver: %s\n", c.Resolver))
+	if len(c.Preload) > 0 {
+		sb.WriteString("resolver_preload: {\n")
+		for _, pub := range sortedKeys(c.Preload) {
+			name := pub + ".conf"
+			if pub == c.SystemAccount {
+				name = "sys.conf"
+			}
+			files[name] = fmt.Sprintf("%s: %s\n", pub, c.Preload[pub])
+			sb.WriteString(fmt.Sprintf("  include \"%s\"\n", name))
+		}
+		sb.WriteString("}\n")
+	}
+
+	return sb.String(), files
+}
+
+// resolverDir lays the preloaded accounts out as a FULL resolver directory: one
+// <public-key>.jwt file per account, including the system account.
+func (c *serverConfig) resolverDir() map[string]string {
+	files := make(map[string]string, len(c.Preload))
+	for pub, jwt := range c.Preload {
+		files[pub+".jwt"] = jwt
+	}
+	return files
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
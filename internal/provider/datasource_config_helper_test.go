@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -144,3 +145,630 @@ data "natsjwt_config_helper" "test" {
 		},
 	})
 }
+
+func TestAccConfigHelperDataSource_SplitMode(t *testing.T) {
+	opKP, _ := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	opPub, _ := opKP.PublicKey()
+
+	sysKP, _ := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	sysPub, _ := sysKP.PublicKey()
+	acctKP, _ := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	acctPub, _ := acctKP.PublicKey()
+
+	opClaims := natsjwt.NewOperatorClaims(opPub)
+	opClaims.Name = "op"
+	opClaims.IssuedAt = 0
+	opClaims.ID = ""
+	opJWT, _ := opClaims.Encode(opKP)
+
+	sysClaims := natsjwt.NewAccountClaims(sysPub)
+	sysClaims.Name = "SYS"
+	sysClaims.IssuedAt = 0
+	sysClaims.ID = ""
+	sysJWT, _ := sysClaims.Encode(opKP)
+
+	acctClaims := natsjwt.NewAccountClaims(acctPub)
+	acctClaims.Name = "app"
+	acctClaims.IssuedAt = 0
+	acctClaims.ID = ""
+	acctJWT, _ := acctClaims.Encode(opKP)
+
+	config := fmt.Sprintf(`
+data "natsjwt_config_helper" "test" {
+  operator_jwt       = %q
+  system_account_jwt = %q
+  account_jwts       = [%q]
+  output_mode        = "split"
+}
+`, opJWT, sysJWT, acctJWT)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "files.%", "3"),
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "files.operator.conf", fmt.Sprintf("operator: %s\n", opJWT)),
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "files.sys.conf", fmt.Sprintf("%s: %s\n", sysPub, sysJWT)),
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "files."+acctPub+".conf", fmt.Sprintf("%s: %s\n", acctPub, acctJWT)),
+					resource.TestCheckResourceAttrWith("data.natsjwt_config_helper.test", "server_config", func(value string) error {
+						if !strings.Contains(value, "include \"sys.conf\"") || !strings.Contains(value, "include \""+acctPub+".conf\"") {
+							return fmt.Errorf("server_config does not include account files: %s", value)
+						}
+						// The operator comes from an include, which nats-server resolves
+						// relative to the config file, not from an operator: file path,
+						// which it resolves relative to its working directory
+						if !strings.HasPrefix(value, "include \"operator.conf\"\n") || strings.Contains(value, "operator:") {
+							return fmt.Errorf("server_config should include operator.conf instead of setting operator: %s", value)
+						}
+						if strings.Contains(value, acctJWT) {
+							return fmt.Errorf("server_config should not inline account JWTs")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_ResolverDirMode(t *testing.T) {
+	opKP, _ := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	opPub, _ := opKP.PublicKey()
+
+	sysKP, _ := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	sysPub, _ := sysKP.PublicKey()
+	acctKP, _ := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	acctPub, _ := acctKP.PublicKey()
+
+	opClaims := natsjwt.NewOperatorClaims(opPub)
+	opClaims.Name = "op"
+	opJWT, _ := opClaims.Encode(opKP)
+
+	sysClaims := natsjwt.NewAccountClaims(sysPub)
+	sysClaims.Name = "SYS"
+	sysJWT, _ := sysClaims.Encode(opKP)
+
+	acctClaims := natsjwt.NewAccountClaims(acctPub)
+	acctClaims.Name = "app"
+	acctJWT, _ := acctClaims.Encode(opKP)
+
+	config := fmt.Sprintf(`
+data "natsjwt_config_helper" "test" {
+  operator_jwt       = %q
+  system_account_jwt = %q
+  account_jwts       = [%q]
+  output_mode        = "resolver_dir"
+}
+
+data "natsjwt_config_helper" "single" {
+  operator_jwt       = %q
+  system_account_jwt = %q
+  account_jwts       = [%q]
+}
+`, opJWT, sysJWT, acctJWT, opJWT, sysJWT, acctJWT)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "files.%", "2"),
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "files."+sysPub+".jwt", sysJWT),
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "files."+acctPub+".jwt", acctJWT),
+					resource.TestCheckResourceAttrPair("data.natsjwt_config_helper.test", "server_config", "data.natsjwt_config_helper.single", "server_config"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_SingleModeNoFiles(t *testing.T) {
+	opKP, _ := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	opPub, _ := opKP.PublicKey()
+
+	opClaims := natsjwt.NewOperatorClaims(opPub)
+	opClaims.Name = "op"
+	opClaims.IssuedAt = 0
+	opClaims.ID = ""
+	opJWT, _ := opClaims.Encode(opKP)
+
+	config := fmt.Sprintf(`
+data "natsjwt_config_helper" "test" {
+  operator_jwt = %q
+}
+`, opJWT)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.natsjwt_config_helper.test", "files.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_ServerNames(t *testing.T) {
+	opKP, _ := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	opPub, _ := opKP.PublicKey()
+
+	opClaims := natsjwt.NewOperatorClaims(opPub)
+	opClaims.Name = "op"
+	opClaims.IssuedAt = 0
+	opClaims.ID = ""
+	opJWT, _ := opClaims.Encode(opKP)
+
+	config := fmt.Sprintf(`
+data "natsjwt_config_helper" "test" {
+  operator_jwt = %q
+  server_names = ["nats-0", "nats-1"]
+}
+`, opJWT)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "server_configs.%", "2"),
+					resource.TestCheckResourceAttrWith("data.natsjwt_config_helper.test", "server_configs.nats-0", func(value string) error {
+						if !strings.HasPrefix(value, "server_name: nats-0\n") || !strings.Contains(value, "resolver: MEMORY") {
+							return fmt.Errorf("unexpected config for nats-0: %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("data.natsjwt_config_helper.test", "server_configs.nats-1", func(value string) error {
+						if !strings.HasPrefix(value, "server_name: nats-1\n") || !strings.Contains(value, "resolver: MEMORY") {
+							return fmt.Errorf("unexpected config for nats-1: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_OperatorOnly(t *testing.T) {
+	opKP, _ := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	opPub, _ := opKP.PublicKey()
+
+	opClaims := natsjwt.NewOperatorClaims(opPub)
+	opClaims.Name = "op"
+	opClaims.IssuedAt = 0
+	opClaims.ID = ""
+	opJWT, _ := opClaims.Encode(opKP)
+
+	config := fmt.Sprintf(`
+data "natsjwt_config_helper" "test" {
+  operator_jwt = %q
+}
+`, opJWT)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "server_config",
+						fmt.Sprintf("operator: %s\nresolver: MEMORY\n", opJWT)),
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "resolver_preload.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_Listeners(t *testing.T) {
+	opKP, _ := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	opPub, _ := opKP.PublicKey()
+
+	opClaims := natsjwt.NewOperatorClaims(opPub)
+	opClaims.Name = "op"
+	opJWT, _ := opClaims.Encode(opKP)
+
+	config := fmt.Sprintf(`
+data "natsjwt_config_helper" "test" {
+  operator_jwt = %q
+  listen       = "0.0.0.0:4222"
+  http_port    = 8222
+  tls = {
+    cert_file = "/etc/nats/server.pem"
+    key_file  = "/etc/nats/server-key.pem"
+    ca_file   = "/etc/nats/ca.pem"
+    verify    = true
+  }
+}
+`, opJWT)
+
+	expected := fmt.Sprintf(`listen: 0.0.0.0:4222
+http_port: 8222
+tls {
+  cert_file: "/etc/nats/server.pem"
+  key_file: "/etc/nats/server-key.pem"
+  ca_file: "/etc/nats/ca.pem"
+  verify: true
+}
+operator: %s
+resolver: MEMORY
+`, opJWT)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "server_config", expected),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_Gateways(t *testing.T) {
+	opKP, _ := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	opPub, _ := opKP.PublicKey()
+
+	opClaims := natsjwt.NewOperatorClaims(opPub)
+	opClaims.Name = "op"
+	opJWT, _ := opClaims.Encode(opKP)
+
+	config := fmt.Sprintf(`
+data "natsjwt_config_helper" "test" {
+  operator_jwt = %q
+  gateways = {
+    name = "west"
+    port = 7222
+    remotes = [
+      { name = "east", url = "nats://east.example.com:7222" },
+      { name = "south", url = "nats://south.example.com:7222" },
+    ]
+  }
+}
+`, opJWT)
+
+	expected := fmt.Sprintf(`gateway {
+  name: "west"
+  port: 7222
+  gateways: [
+    {name: "east", url: "nats://east.example.com:7222"}
+    {name: "south", url: "nats://south.example.com:7222"}
+  ]
+}
+operator: %s
+resolver: MEMORY
+`, opJWT)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "server_config", expected),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_InvalidHTTPPort(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "natsjwt_config_helper" "test" {
+  operator_jwt = "unused"
+  http_port    = 70000
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Port`),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_StrictSystemAccount(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	sysSeed := testAccountSeed(t)
+	_, otherPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := func(operatorSystemAccount string) string {
+		return fmt.Sprintf(`
+data "natsjwt_system_account" "sys" {
+  name          = "SYS"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_operator" "op" {
+  name           = "op"
+  seed           = %q
+  system_account = %s
+}
+
+data "natsjwt_config_helper" "test" {
+  operator_jwt          = data.natsjwt_operator.op.jwt
+  system_account_jwt    = data.natsjwt_system_account.sys.jwt
+  strict_system_account = true
+}
+`, sysSeed, opSeed, opSeed, operatorSystemAccount)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(fmt.Sprintf("%q", otherPub)),
+				ExpectError: regexp.MustCompile(`System Account Mismatch`),
+			},
+			{
+				Config: config("data.natsjwt_system_account.sys.public_key"),
+				Check:  resource.TestCheckResourceAttrPair("data.natsjwt_config_helper.test", "system_account", "data.natsjwt_system_account.sys", "public_key"),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_SystemAccountNotPreloaded(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	_, sysPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "op" {
+  name           = "op"
+  seed           = %q
+  system_account = %q
+}
+
+data "natsjwt_config_helper" "test" {
+  operator_jwt = data.natsjwt_operator.op.jwt
+}
+`, opSeed, sysPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "warnings.#", "1"),
+					resource.TestMatchResourceAttr("data.natsjwt_config_helper.test", "warnings.0",
+						regexp.MustCompile(fmt.Sprintf(`operator system account %s is not preloaded`, sysPub))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_DuplicateAccountKeys(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	sysSeed, sysPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "op" {
+  name           = "op"
+  seed           = %[1]q
+  system_account = %[3]q
+}
+
+data "natsjwt_system_account" "sys" {
+  name          = "SYS"
+  seed          = %[2]q
+  operator_seed = %[1]q
+}
+
+data "natsjwt_account" "sys_copy" {
+  name          = "SYS-old"
+  seed          = %[2]q
+  operator_seed = %[1]q
+}
+
+data "natsjwt_account" "v1" {
+  name          = "app-v1"
+  seed          = %[4]q
+  operator_seed = %[1]q
+}
+
+data "natsjwt_account" "v2" {
+  name          = "app-v2"
+  seed          = %[4]q
+  operator_seed = %[1]q
+}
+
+data "natsjwt_config_helper" "test" {
+  operator_jwt       = data.natsjwt_operator.op.jwt
+  system_account_jwt = data.natsjwt_system_account.sys.jwt
+  account_jwts = [
+    data.natsjwt_account.sys_copy.jwt,
+    data.natsjwt_account.v1.jwt,
+    data.natsjwt_account.v1.jwt,
+    data.natsjwt_account.v2.jwt,
+  ]
+}
+`, opSeed, sysSeed, sysPub, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The identical v1 entries are not reported; only the differing ones are.
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "warnings.#", "2"),
+					resource.TestMatchResourceAttr("data.natsjwt_config_helper.test", "warnings.0",
+						regexp.MustCompile(fmt.Sprintf(`different JWT for system account %s; system_account_jwt is used`, sysPub))),
+					resource.TestMatchResourceAttr("data.natsjwt_config_helper.test", "warnings.1",
+						regexp.MustCompile(fmt.Sprintf(`differing JWTs for account %s; the last one is used`, acctPub))),
+					resource.TestCheckResourceAttrPair("data.natsjwt_config_helper.test", "resolver_preload."+sysPub,
+						"data.natsjwt_system_account.sys", "jwt"),
+					resource.TestCheckResourceAttrPair("data.natsjwt_config_helper.test", "resolver_preload."+acctPub,
+						"data.natsjwt_account.v2", "jwt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_InvalidOperatorJWT(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "natsjwt_config_helper" "test" {
+  operator_jwt = "not-a-jwt"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Operator JWT`),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_RevokeBefore(t *testing.T) {
+	opKP, _ := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	opPub, _ := opKP.PublicKey()
+
+	staleKP, _ := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	stalePub, _ := staleKP.PublicKey()
+	freshKP, _ := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	freshPub, _ := freshKP.PublicKey()
+
+	opClaims := natsjwt.NewOperatorClaims(opPub)
+	opClaims.Name = "op"
+	opJWT, _ := encodeDeterministic(opClaims, opKP)
+
+	staleClaims := natsjwt.NewAccountClaims(stalePub)
+	staleClaims.Name = "stale"
+	staleClaims.IssuedAt = 100
+	staleJWT, _ := encodeDeterministic(staleClaims, opKP)
+
+	freshClaims := natsjwt.NewAccountClaims(freshPub)
+	freshClaims.Name = "fresh"
+	freshClaims.IssuedAt = 300
+	freshJWT, _ := encodeDeterministic(freshClaims, opKP)
+
+	config := fmt.Sprintf(`
+data "natsjwt_config_helper" "test" {
+  operator_jwt       = %q
+  account_jwts       = [%q, %q]
+  revoke_before_unix = 200
+}
+`, opJWT, staleJWT, freshJWT)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "warnings.#", "1"),
+					resource.TestMatchResourceAttr("data.natsjwt_config_helper.test", "warnings.0", regexp.MustCompile(stalePub)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_OperatorTags(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "op" {
+  name = "tagged-op"
+  seed = %q
+  tags = ["env:prod"]
+}
+
+data "natsjwt_config_helper" "test" {
+  operator_jwt = data.natsjwt_operator.op.jwt
+}
+`, testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "operator_tags.#", "1"),
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "operator_tags.0", "env:prod"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigHelperDataSource_JetStream(t *testing.T) {
+	opKP, _ := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	opPub, _ := opKP.PublicKey()
+	opClaims := natsjwt.NewOperatorClaims(opPub)
+	opClaims.Name = "op"
+	opJWT, _ := opClaims.Encode(opKP)
+
+	plainKP, _ := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	plainPub, _ := plainKP.PublicKey()
+	plainClaims := natsjwt.NewAccountClaims(plainPub)
+	plainClaims.Name = "plain"
+	plainJWT, _ := plainClaims.Encode(opKP)
+
+	jsKP, _ := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	jsPub, _ := jsKP.PublicKey()
+	jsClaims := natsjwt.NewAccountClaims(jsPub)
+	jsClaims.Name = "js"
+	jsClaims.Limits.JetStreamLimits.DiskStorage = 1 << 30
+	jsJWT, _ := jsClaims.Encode(opKP)
+
+	config := func(accountJWTs []string, enable string) string {
+		return fmt.Sprintf(`
+data "natsjwt_config_helper" "test" {
+  operator_jwt     = %q
+  account_jwts     = [%s]
+  enable_jetstream = %s
+}
+`, opJWT, `"`+strings.Join(accountJWTs, `", "`)+`"`, enable)
+	}
+	hasBlock := func(want bool) resource.TestCheckFunc {
+		return resource.TestCheckResourceAttrWith("data.natsjwt_config_helper.test", "server_config", func(value string) error {
+			if got := strings.HasPrefix(value, "jetstream {}\n"); got != want {
+				return fmt.Errorf("expected jetstream block %t, got config:\n%s", want, value)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config([]string{plainJWT}, "null"),
+				Check:  hasBlock(false),
+			},
+			{
+				Config: config([]string{plainJWT, jsJWT}, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					hasBlock(true),
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "warnings.#", "0"),
+				),
+			},
+			{
+				Config: config([]string{plainJWT}, "true"),
+				Check:  hasBlock(true),
+			},
+			{
+				Config: config([]string{plainJWT, jsJWT}, "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					hasBlock(false),
+					resource.TestCheckResourceAttr("data.natsjwt_config_helper.test", "warnings.#", "1"),
+					resource.TestMatchResourceAttr("data.natsjwt_config_helper.test", "warnings.0", regexp.MustCompile(jsPub+" has JetStream limits")),
+				),
+			},
+		},
+	})
+}
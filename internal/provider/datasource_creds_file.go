@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ datasource.DataSource = &CredsFileDataSource{}
+
+type CredsFileDataSource struct{}
+
+type CredsFileDataSourceModel struct {
+	Path      types.String `tfsdk:"path"`
+	JWT       types.String `tfsdk:"jwt"`
+	PublicKey types.String `tfsdk:"public_key"`
+	Name      types.String `tfsdk:"name"`
+	Issuer    types.String `tfsdk:"issuer"`
+	ClaimType types.String `tfsdk:"claim_type"`
+	IssuedAt  types.Int64  `tfsdk:"issued_at"`
+	Expires   types.Int64  `tfsdk:"expires"`
+	HasSeed   types.Bool   `tfsdk:"has_seed"`
+}
+
+func NewCredsFileDataSource() datasource.DataSource {
+	return &CredsFileDataSource{}
+}
+
+func (d *CredsFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_creds_file"
+}
+
+func (d *CredsFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a decorated NATS creds or JWT file from disk and reports the JWT and its claim metadata.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path to a .creds file or a decorated (or plain) .jwt file.",
+			},
+			"jwt": schema.StringAttribute{
+				Computed:    true,
+				Description: "The JWT contained in the file.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The JWT subject public key.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The JWT name claim.",
+			},
+			"issuer": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key that signed the JWT.",
+			},
+			"claim_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NATS claim type, e.g. operator, account or user.",
+			},
+			"issued_at": schema.Int64Attribute{
+				Computed:    true,
+				Description: "JWT issued-at Unix timestamp.",
+			},
+			"expires": schema.Int64Attribute{
+				Computed:    true,
+				Description: "JWT expiration Unix timestamp. 0 when the JWT does not expire.",
+			},
+			"has_seed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True when the file also contains an NKey seed. The seed is checked against public_key but never stored.",
+			},
+		},
+	}
+}
+
+func (d *CredsFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CredsFileDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filePath := data.Path.ValueString()
+	contents, err := os.ReadFile(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Creds File Not Found", fmt.Sprintf("No file exists at %s", filePath))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Unreadable Creds File", fmt.Sprintf("Failed to read %s: %s", filePath, err))
+		return
+	}
+
+	jwtStr, err := natsjwt.ParseDecoratedJWT(contents)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Creds", fmt.Sprintf("Failed to extract JWT from %s: %s", filePath, err))
+		return
+	}
+
+	claims, err := natsjwt.DecodeGeneric(jwtStr)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid JWT", fmt.Sprintf("Failed to decode JWT from %s: %s", filePath, err))
+		return
+	}
+
+	// ParseDecoratedNKey cannot tell a missing seed from a malformed one, so
+	// only parse when the file has a seed block.
+	hasSeed := bytes.Contains(contents, []byte("NKEY SEED-----"))
+	if hasSeed {
+		kp, err := natsjwt.ParseDecoratedNKey(contents)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Creds", fmt.Sprintf("Failed to extract seed from %s: %s", filePath, err))
+			return
+		}
+		seedPub, err := kp.PublicKey()
+		if err != nil {
+			resp.Diagnostics.AddError("Public Key Error", fmt.Sprintf("Failed to get seed public key: %s", err))
+			return
+		}
+		if seedPub != claims.Subject {
+			resp.Diagnostics.AddError("Mismatched Creds Seed",
+				fmt.Sprintf("The seed in %s belongs to %s, but the JWT subject is %s", filePath, seedPub, claims.Subject))
+			return
+		}
+	}
+
+	data.JWT = types.StringValue(jwtStr)
+	data.PublicKey = types.StringValue(claims.Subject)
+	data.Name = types.StringValue(claims.Name)
+	data.Issuer = types.StringValue(claims.Issuer)
+	data.ClaimType = types.StringValue(string(claims.ClaimType()))
+	data.IssuedAt = types.Int64Value(claims.IssuedAt)
+	data.Expires = types.Int64Value(claims.Expires)
+	data.HasSeed = types.BoolValue(hasSeed)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccCredsFileDataSource_Basic(t *testing.T) {
+	acctKP, err := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acctPub, _ := acctKP.PublicKey()
+	userSeed, userPub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+
+	claims := natsjwt.NewUserClaims(userPub)
+	claims.Name = "file-user"
+	claims.Expires = 4000000000
+	userJWT, err := claims.Encode(acctKP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds, err := natsjwt.FormatUserConfig(userJWT, []byte(userSeed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	credsPath := filepath.Join(t.TempDir(), "user.creds")
+	if err := os.WriteFile(credsPath, creds, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_creds_file" "test" {
+  path = %q
+}
+`, credsPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_creds_file.test", "jwt", userJWT),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_file.test", "public_key", userPub),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_file.test", "name", "file-user"),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_file.test", "issuer", acctPub),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_file.test", "claim_type", "user"),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_file.test", "expires", "4000000000"),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_file.test", "has_seed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCredsFileDataSource_MissingFile(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.creds")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_creds_file" "test" {
+  path = %q
+}
+`, missing),
+				ExpectError: regexp.MustCompile(`Creds File Not Found`),
+			},
+		},
+	})
+}
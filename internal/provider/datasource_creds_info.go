@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ datasource.DataSource = &CredsInfoDataSource{}
+
+type CredsInfoDataSource struct{}
+
+type CredsInfoDataSourceModel struct {
+	Creds         types.String `tfsdk:"creds"`
+	ReferenceUnix types.Int64  `tfsdk:"reference_unix"`
+	PublicKey     types.String `tfsdk:"public_key"`
+	Account       types.String `tfsdk:"account"`
+	Expires       types.Int64  `tfsdk:"expires"`
+	IsExpired     types.Bool   `tfsdk:"is_expired"`
+}
+
+func NewCredsInfoDataSource() datasource.DataSource {
+	return &CredsInfoDataSource{}
+}
+
+func (d *CredsInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_creds_info"
+}
+
+func (d *CredsInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Decodes the user JWT embedded in a NATS creds file and reports its identity and expiry.",
+		Attributes: map[string]schema.Attribute{
+			"creds": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Decorated NATS user credentials (.creds file content).",
+			},
+			"reference_unix": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Unix timestamp used to evaluate is_expired. Defaults to the current time.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The user public key.",
+			},
+			"account": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key of the account the user belongs to.",
+			},
+			"expires": schema.Int64Attribute{
+				Computed:    true,
+				Description: "JWT expiration Unix timestamp. 0 when the JWT does not expire.",
+			},
+			"is_expired": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True when the JWT has expired at reference_unix.",
+			},
+		},
+	}
+}
+
+func (d *CredsInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CredsInfoDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jwtStr, err := natsjwt.ParseDecoratedJWT([]byte(data.Creds.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Creds", fmt.Sprintf("Failed to extract JWT from creds: %s", err))
+		return
+	}
+
+	claims, err := natsjwt.DecodeUserClaims(jwtStr)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User JWT", fmt.Sprintf("Failed to decode user JWT from creds: %s", err))
+		return
+	}
+
+	account := claims.Issuer
+	if claims.IssuerAccount != "" {
+		account = claims.IssuerAccount
+	}
+
+	reference := time.Now().Unix()
+	if !data.ReferenceUnix.IsNull() {
+		reference = data.ReferenceUnix.ValueInt64()
+	}
+
+	data.PublicKey = types.StringValue(claims.Subject)
+	data.Account = types.StringValue(account)
+	data.Expires = types.Int64Value(claims.Expires)
+	// Same rule as the jwt library's validation: expired strictly after exp.
+	data.IsExpired = types.BoolValue(claims.Expires > 0 && reference > claims.Expires)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccCredsInfoDataSource_Expired(t *testing.T) {
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "expiring-user"
+  seed         = %q
+  account_seed = %q
+  expires      = 1000
+}
+
+data "natsjwt_creds_info" "before" {
+  creds          = data.natsjwt_user.test.creds
+  reference_unix = 500
+}
+
+data "natsjwt_creds_info" "after" {
+  creds          = data.natsjwt_user.test.creds
+  reference_unix = 2000
+}
+`, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.natsjwt_creds_info.after", "public_key", "data.natsjwt_user.test", "public_key"),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_info.after", "account", acctPub),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_info.after", "expires", "1000"),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_info.after", "is_expired", "true"),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_info.before", "is_expired", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCredsInfoDataSource_NoExpiry(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "forever-user"
+  seed         = %q
+  account_seed = %q
+}
+
+data "natsjwt_creds_info" "test" {
+  creds = data.natsjwt_user.test.creds
+}
+`, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_creds_info.test", "expires", "0"),
+					resource.TestCheckResourceAttr("data.natsjwt_creds_info.test", "is_expired", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ datasource.DataSource = &DecodedOperatorDataSource{}
+
+type DecodedOperatorDataSource struct{}
+
+type DecodedOperatorDataSourceModel struct {
+	OperatorJWT      types.String `tfsdk:"operator_jwt"`
+	Name             types.String `tfsdk:"name"`
+	PublicKey        types.String `tfsdk:"public_key"`
+	SigningKeys      types.List   `tfsdk:"signing_keys"`
+	SystemAccount    types.String `tfsdk:"system_account"`
+	AccountServerURL types.String `tfsdk:"account_server_url"`
+	IssuedAt         types.Int64  `tfsdk:"issued_at"`
+	Expires          types.Int64  `tfsdk:"expires"`
+	NotBefore        types.Int64  `tfsdk:"not_before"`
+}
+
+func NewDecodedOperatorDataSource() datasource.DataSource {
+	return &DecodedOperatorDataSource{}
+}
+
+func (d *DecodedOperatorDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_decoded_operator"
+}
+
+func (d *DecodedOperatorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Decodes an existing operator JWT, e.g. one issued by nsc, so its keys and settings can drive other resources.",
+		Attributes: map[string]schema.Attribute{
+			"operator_jwt": schema.StringAttribute{
+				Required:    true,
+				Description: "Operator JWT to decode.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Operator name.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Operator public key.",
+			},
+			"signing_keys": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Operator signing key public keys, sorted.",
+			},
+			"system_account": schema.StringAttribute{
+				Computed:    true,
+				Description: "System account public key. Null when the operator has none.",
+			},
+			"account_server_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account server URL. Null when not set.",
+			},
+			"issued_at": schema.Int64Attribute{
+				Computed:    true,
+				Description: "JWT issued-at Unix timestamp.",
+			},
+			"expires": schema.Int64Attribute{
+				Computed:    true,
+				Description: "JWT expiration Unix timestamp. 0 when the JWT does not expire.",
+			},
+			"not_before": schema.Int64Attribute{
+				Computed:    true,
+				Description: "JWT not-before Unix timestamp. 0 when not set.",
+			},
+		},
+	}
+}
+
+func (d *DecodedOperatorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DecodedOperatorDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claims, err := natsjwt.DecodeOperatorClaims(data.OperatorJWT.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("operator_jwt"), "Invalid Operator JWT", fmt.Sprintf("Failed to decode operator JWT: %s", err))
+		return
+	}
+
+	signingKeys := append([]string{}, claims.SigningKeys...)
+	sort.Strings(signingKeys)
+	keysValue, diags := types.ListValueFrom(ctx, types.StringType, signingKeys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Name = types.StringValue(claims.Name)
+	data.PublicKey = types.StringValue(claims.Subject)
+	data.SigningKeys = keysValue
+	data.SystemAccount = types.StringNull()
+	if claims.SystemAccount != "" {
+		data.SystemAccount = types.StringValue(claims.SystemAccount)
+	}
+	data.AccountServerURL = types.StringNull()
+	if claims.AccountServerURL != "" {
+		data.AccountServerURL = types.StringValue(claims.AccountServerURL)
+	}
+	data.IssuedAt = types.Int64Value(claims.IssuedAt)
+	data.Expires = types.Int64Value(claims.Expires)
+	data.NotBefore = types.Int64Value(claims.NotBefore)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccDecodedOperatorDataSource_RoundTrip(t *testing.T) {
+	opSeed, opPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	_, sigPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	_, sysPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "source" {
+  name               = "imported-op"
+  seed               = %q
+  signing_keys       = [%q]
+  system_account     = %q
+  account_server_url = "nats://localhost:4222"
+  issued_at          = 100
+  not_before         = 150
+  expires            = 200
+}
+
+data "natsjwt_decoded_operator" "test" {
+  operator_jwt = data.natsjwt_operator.source.jwt
+}
+`, opSeed, sigPub, sysPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "name", "imported-op"),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "public_key", opPub),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "signing_keys.#", "1"),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "signing_keys.0", sigPub),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "system_account", sysPub),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "account_server_url", "nats://localhost:4222"),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "issued_at", "100"),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "not_before", "150"),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "expires", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDecodedOperatorDataSource_Minimal(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "source" {
+  name = "bare-op"
+  seed = %q
+}
+
+data "natsjwt_decoded_operator" "test" {
+  operator_jwt = data.natsjwt_operator.source.jwt
+}
+`, testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.natsjwt_decoded_operator.test", "public_key", "data.natsjwt_operator.source", "public_key"),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "signing_keys.#", "0"),
+					resource.TestCheckNoResourceAttr("data.natsjwt_decoded_operator.test", "system_account"),
+					resource.TestCheckNoResourceAttr("data.natsjwt_decoded_operator.test", "account_server_url"),
+					resource.TestCheckResourceAttr("data.natsjwt_decoded_operator.test", "expires", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDecodedOperatorDataSource_NotOperator(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_account" "acct" {
+  name          = "app"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_decoded_operator" "test" {
+  operator_jwt = data.natsjwt_account.acct.jwt
+}
+`, testAccountSeed(t), testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Operator JWT`),
+			},
+		},
+	})
+}
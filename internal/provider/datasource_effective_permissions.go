@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ datasource.DataSource = &EffectivePermissionsDataSource{}
+
+type EffectivePermissionsDataSource struct{}
+
+type EffectivePermissionsDataSourceModel struct {
+	AccountJWT types.String `tfsdk:"account_jwt"`
+	UserJWT    types.String `tfsdk:"user_jwt"`
+	Inherited  types.Bool   `tfsdk:"inherited"`
+	PubAllow   types.List   `tfsdk:"pub_allow"`
+	PubDeny    types.List   `tfsdk:"pub_deny"`
+	SubAllow   types.List   `tfsdk:"sub_allow"`
+	SubDeny    types.List   `tfsdk:"sub_deny"`
+}
+
+func NewEffectivePermissionsDataSource() datasource.DataSource {
+	return &EffectivePermissionsDataSource{}
+}
+
+func (d *EffectivePermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_permissions"
+}
+
+func (d *EffectivePermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the publish/subscribe permissions a NATS server applies to a user, taking the account's default permissions into account.",
+		Attributes: map[string]schema.Attribute{
+			"account_jwt": schema.StringAttribute{
+				Required:    true,
+				Description: "The account JWT the user belongs to.",
+			},
+			"user_jwt": schema.StringAttribute{
+				Required:    true,
+				Description: "The user JWT.",
+			},
+			"inherited": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True when the user has no permissions of its own and inherits the account's default permissions.",
+			},
+			"pub_allow": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Effective subjects allowed for publishing.",
+			},
+			"pub_deny": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Effective subjects denied for publishing.",
+			},
+			"sub_allow": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Effective subjects allowed for subscribing.",
+			},
+			"sub_deny": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Effective subjects denied for subscribing.",
+			},
+		},
+	}
+}
+
+func (d *EffectivePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EffectivePermissionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acctClaims, err := natsjwt.DecodeAccountClaims(data.AccountJWT.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Account JWT", fmt.Sprintf("Failed to decode account JWT: %s", err))
+		return
+	}
+
+	userClaims, err := natsjwt.DecodeUserClaims(data.UserJWT.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User JWT", fmt.Sprintf("Failed to decode user JWT: %s", err))
+		return
+	}
+
+	userAccount := userClaims.Issuer
+	if userClaims.IssuerAccount != "" {
+		userAccount = userClaims.IssuerAccount
+	}
+	if userAccount != acctClaims.Subject {
+		resp.Diagnostics.AddError("User Not In Account",
+			fmt.Sprintf("User JWT belongs to account %s, but the account JWT is for %s", userAccount, acctClaims.Subject))
+		return
+	}
+
+	// Mirror nats-server: a user without any permissions of its own gets the account defaults.
+	perms := userClaims.Permissions
+	inherited := perms.Pub.Empty() && perms.Sub.Empty() && perms.Resp == nil
+	if inherited {
+		perms = acctClaims.DefaultPermissions
+	}
+
+	lists := []struct {
+		target *types.List
+		values natsjwt.StringList
+	}{
+		{&data.PubAllow, perms.Pub.Allow},
+		{&data.PubDeny, perms.Pub.Deny},
+		{&data.SubAllow, perms.Sub.Allow},
+		{&data.SubDeny, perms.Sub.Deny},
+	}
+	for _, l := range lists {
+		values := make([]string, 0, len(l.values))
+		values = append(values, l.values...)
+		list, diags := types.ListValueFrom(ctx, types.StringType, values)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		*l.target = list
+	}
+
+	data.Inherited = types.BoolValue(inherited)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
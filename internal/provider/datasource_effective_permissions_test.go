@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEffectivePermissionsDataSource_InheritsAccountDefaults(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "defaults-acct"
+  seed          = %q
+  operator_seed = %q
+  default_permissions = {
+    pub_allow = ["orders.>"]
+    sub_allow = ["_INBOX.>"]
+    sub_deny  = ["secret.>"]
+  }
+}
+
+data "natsjwt_user" "test" {
+  name         = "plain-user"
+  seed         = %q
+  account_seed = %q
+}
+
+data "natsjwt_effective_permissions" "test" {
+  account_jwt = data.natsjwt_account.test.jwt
+  user_jwt    = data.natsjwt_user.test.jwt
+}
+`, acctSeed, opSeed, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_effective_permissions.test", "inherited", "true"),
+					resource.TestCheckResourceAttr("data.natsjwt_effective_permissions.test", "pub_allow.#", "1"),
+					resource.TestCheckResourceAttr("data.natsjwt_effective_permissions.test", "pub_allow.0", "orders.>"),
+					resource.TestCheckResourceAttr("data.natsjwt_effective_permissions.test", "pub_deny.#", "0"),
+					resource.TestCheckResourceAttr("data.natsjwt_effective_permissions.test", "sub_allow.0", "_INBOX.>"),
+					resource.TestCheckResourceAttr("data.natsjwt_effective_permissions.test", "sub_deny.0", "secret.>"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEffectivePermissionsDataSource_UserOverrides(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "defaults-acct"
+  seed          = %q
+  operator_seed = %q
+  default_permissions = {
+    pub_allow = ["orders.>"]
+  }
+}
+
+data "natsjwt_user" "test" {
+  name         = "own-perms-user"
+  seed         = %q
+  account_seed = %q
+  permissions = {
+    pub_allow = ["billing.>"]
+  }
+}
+
+data "natsjwt_effective_permissions" "test" {
+  account_jwt = data.natsjwt_account.test.jwt
+  user_jwt    = data.natsjwt_user.test.jwt
+}
+`, acctSeed, opSeed, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_effective_permissions.test", "inherited", "false"),
+					resource.TestCheckResourceAttr("data.natsjwt_effective_permissions.test", "pub_allow.#", "1"),
+					resource.TestCheckResourceAttr("data.natsjwt_effective_permissions.test", "pub_allow.0", "billing.>"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nats-io/nkeys"
+)
+
+var _ datasource.DataSource = &EnvSeedDataSource{}
+
+type EnvSeedDataSource struct{}
+
+type EnvSeedDataSourceModel struct {
+	EnvVar    types.String `tfsdk:"env_var"`
+	Type      types.String `tfsdk:"type"`
+	Seed      types.String `tfsdk:"seed"`
+	PublicKey types.String `tfsdk:"public_key"`
+}
+
+func NewEnvSeedDataSource() datasource.DataSource {
+	return &EnvSeedDataSource{}
+}
+
+func (d *EnvSeedDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env_seed"
+}
+
+func (d *EnvSeedDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an NKey seed from an environment variable of the Terraform process, so seeds never appear in configuration.",
+		Attributes: map[string]schema.Attribute{
+			"env_var": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the environment variable holding the seed.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Expected key type: operator, account or user.",
+				Validators:  []validator.String{NkeyTypeValidator()},
+			},
+			"seed": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The seed read from the environment variable.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key derived from the seed.",
+			},
+		},
+	}
+}
+
+func (d *EnvSeedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvSeedDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envVar := data.EnvVar.ValueString()
+	seed, ok := os.LookupEnv(envVar)
+	seed = strings.TrimSpace(seed)
+	if !ok || seed == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("env_var"), "Seed Environment Variable Not Set",
+			fmt.Sprintf("Environment variable %s is not set or empty", envVar))
+		return
+	}
+
+	expected, err := prefixByteFromType(data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid NKey Type", err.Error())
+		return
+	}
+
+	// Never echo the value: it is a secret even when malformed.
+	prefix, _, err := nkeys.DecodeSeed([]byte(seed))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("env_var"), "Invalid NKey Seed",
+			fmt.Sprintf("Environment variable %s does not contain a valid NKey seed", envVar))
+		return
+	}
+	if prefix != expected {
+		resp.Diagnostics.AddAttributeError(path.Root("env_var"), "Wrong NKey Seed Type",
+			fmt.Sprintf("Environment variable %s holds a %s seed, expected %s", envVar, prefixName(prefix), prefixName(expected)))
+		return
+	}
+
+	pub, err := publicKeyFromSeed(seed)
+	if err != nil {
+		resp.Diagnostics.AddError("Public Key Error", fmt.Sprintf("Failed to derive public key from %s: %s", envVar, err))
+		return
+	}
+
+	data.Seed = types.StringValue(seed)
+	data.PublicKey = types.StringValue(pub)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
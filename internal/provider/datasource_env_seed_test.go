@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccEnvSeedDataSource_Account(t *testing.T) {
+	seed, pub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	t.Setenv("NATSJWT_TEST_ACCOUNT_SEED", seed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "natsjwt_env_seed" "test" {
+  env_var = "NATSJWT_TEST_ACCOUNT_SEED"
+  type    = "account"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_env_seed.test", "public_key", pub),
+					resource.TestCheckResourceAttr("data.natsjwt_env_seed.test", "seed", seed),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEnvSeedDataSource_Unset(t *testing.T) {
+	t.Setenv("NATSJWT_TEST_UNSET_SEED", "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "natsjwt_env_seed" "test" {
+  env_var = "NATSJWT_TEST_UNSET_SEED"
+  type    = "account"
+}
+`,
+				ExpectError: regexp.MustCompile(`Seed Environment Variable Not Set`),
+			},
+		},
+	})
+}
+
+func TestAccEnvSeedDataSource_WrongType(t *testing.T) {
+	t.Setenv("NATSJWT_TEST_USER_SEED", testUserSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "natsjwt_env_seed" "test" {
+  env_var = "NATSJWT_TEST_USER_SEED"
+  type    = "account"
+}
+`,
+				ExpectError: regexp.MustCompile(`Wrong NKey Seed Type`),
+			},
+		},
+	})
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+var _ datasource.DataSource = &LeafnodeAccountDataSource{}
+var _ datasource.DataSourceWithConfigure = &LeafnodeAccountDataSource{}
+
+type LeafnodeAccountDataSource struct {
+	jwtSettings
+}
+
+type LeafnodeAccountDataSourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	Seed          types.String `tfsdk:"seed"`
+	OperatorSeed  types.String `tfsdk:"operator_seed"`
+	LeafNodeConn  types.Int64  `tfsdk:"leaf_node_conn"`
+	Conn          types.Int64  `tfsdk:"conn"`
+	IssuedAt      types.Int64  `tfsdk:"issued_at"`
+	Expires       types.Int64  `tfsdk:"expires"`
+	UserName      types.String `tfsdk:"user_name"`
+	UserSeed      types.String `tfsdk:"user_seed"`
+	PublicKey     types.String `tfsdk:"public_key"`
+	JWT           types.String `tfsdk:"jwt"`
+	UserPublicKey types.String `tfsdk:"user_public_key"`
+	UserJWT       types.String `tfsdk:"user_jwt"`
+	UserCreds     types.String `tfsdk:"user_creds"`
+}
+
+func NewLeafnodeAccountDataSource() datasource.DataSource {
+	return &LeafnodeAccountDataSource{}
+}
+
+func (d *LeafnodeAccountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leafnode_account"
+}
+
+func (d *LeafnodeAccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a dedicated leaf node account with leaf-node defaults (leaf node connections allowed, JetStream disabled) together with a user that may only connect as a leaf node.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Account name.",
+				Validators:  []validator.String{NameValidator()},
+			},
+			"seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Account NKey seed (starts with SA).",
+				Validators:  []validator.String{SeedTypeValidator(nkeys.PrefixByteAccount)},
+			},
+			"operator_seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Operator or operator signing key seed used to sign the account JWT (starts with SO).",
+				Validators:  []validator.String{SeedTypeValidator(nkeys.PrefixByteOperator)},
+			},
+			"leaf_node_conn": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum leaf node connections. Defaults to 1. -1 for unlimited; 0 is rejected because it would disable leaf nodes.",
+			},
+			"conn": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum concurrent client connections across all users of the account. Defaults to -1 (unlimited).",
+			},
+			"issued_at": schema.Int64Attribute{
+				Optional:    true,
+				Description: "JWT issued-at timestamp as Unix seconds for the account and user JWTs. Defaults to 0 (Unix epoch).",
+			},
+			"expires": schema.Int64Attribute{
+				Optional:    true,
+				Description: "JWT expiration timestamp as Unix seconds for the account and user JWTs. Defaults to no expiration.",
+			},
+			"user_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the bundled leaf node user. Defaults to \"leaf\".",
+				Validators:  []validator.String{NameValidator()},
+			},
+			"user_seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "User NKey seed for the bundled leaf node user (starts with SU).",
+				Validators:  []validator.String{SeedTypeValidator(nkeys.PrefixByteUser)},
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Account public key.",
+			},
+			"jwt": schema.StringAttribute{
+				Computed:    true,
+				Description: "The signed account JWT.",
+			},
+			"user_public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key of the bundled leaf node user.",
+			},
+			"user_jwt": schema.StringAttribute{
+				Computed:    true,
+				Description: "The signed user JWT of the bundled leaf node user.",
+			},
+			"user_creds": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "NATS credentials file content for the bundled leaf node user, for use in a leafnodes remote.",
+			},
+		},
+	}
+}
+
+func (d *LeafnodeAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LeafnodeAccountDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	leafNodeConn := int64(1)
+	if !data.LeafNodeConn.IsNull() {
+		leafNodeConn = data.LeafNodeConn.ValueInt64()
+	}
+	if leafNodeConn == 0 || leafNodeConn < -1 {
+		resp.Diagnostics.AddAttributeError(path.Root("leaf_node_conn"), "Invalid Leaf Node Connections",
+			fmt.Sprintf("leaf_node_conn must be positive or -1 for unlimited, got %d", leafNodeConn))
+		return
+	}
+
+	operatorKP, err := keypairFromSeed(data.OperatorSeed.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Operator Seed", fmt.Sprintf("Failed to parse operator seed: %s", err))
+		return
+	}
+
+	// Account, built like natsjwt_account with only the leaf node limits set.
+	// NewAccountClaims leaves JetStream limits at zero, which keeps JetStream disabled.
+	accountClaims, accountPub, err := buildAccountClaims(ctx, &AccountDataSourceModel{
+		Name:     data.Name,
+		Seed:     data.Seed,
+		IssuedAt: data.IssuedAt,
+		Expires:  data.Expires,
+	}, d.issuedAtRoundTo, resp)
+	if err != nil || resp.Diagnostics.HasError() {
+		return
+	}
+	accountClaims.Limits.LeafNodeConn = leafNodeConn
+	accountClaims.Limits.Conn = -1
+	if !data.Conn.IsNull() {
+		accountClaims.Limits.Conn = data.Conn.ValueInt64()
+	}
+
+	accountJWT, err := encodeDeterministic(accountClaims, operatorKP)
+	if err != nil {
+		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode account JWT: %s", err))
+		return
+	}
+	d.checkJWTSize("account", accountJWT, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userName := data.UserName
+	if userName.IsNull() {
+		userName = types.StringValue("leaf")
+	}
+	userClaims, userPub, err := buildUserClaims(ctx, &UserDataSourceModel{
+		Name:        userName,
+		Seed:        data.UserSeed,
+		AccountSeed: data.Seed,
+		IssuedAt:    data.IssuedAt,
+		Expires:     data.Expires,
+		AllowedConnectionTypes: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue(natsjwt.ConnectionTypeLeafnode),
+		}),
+	}, d.issuedAtRoundTo, resp)
+	if err != nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountKP, err := keypairFromSeed(data.Seed.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Account Seed", fmt.Sprintf("Failed to parse account seed: %s", err))
+		return
+	}
+
+	userJWT, err := encodeDeterministic(userClaims, accountKP)
+	if err != nil {
+		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode user JWT: %s", err))
+		return
+	}
+	d.checkJWTSize("user", userJWT, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds, err := natsjwt.FormatUserConfig(userJWT, []byte(data.UserSeed.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Credentials Encoding Error", fmt.Sprintf("Failed to encode user credentials: %s", err))
+		return
+	}
+
+	data.PublicKey = types.StringValue(accountPub)
+	data.JWT = types.StringValue(accountJWT)
+	data.UserPublicKey = types.StringValue(userPub)
+	data.UserJWT = types.StringValue(userJWT)
+	data.UserCreds = types.StringValue(string(creds))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccLeafnodeAccountDataSource_Basic(t *testing.T) {
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	userSeed, userPub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+
+	config := fmt.Sprintf(`
+data "natsjwt_leafnode_account" "test" {
+  name          = "edge"
+  seed          = %q
+  operator_seed = %q
+  user_seed     = %q
+}
+`, acctSeed, testOperatorSeed(t), userSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_leafnode_account.test", "public_key", acctPub),
+					resource.TestCheckResourceAttr("data.natsjwt_leafnode_account.test", "user_public_key", userPub),
+					resource.TestCheckResourceAttrWith("data.natsjwt_leafnode_account.test", "jwt", func(value string) error {
+						claims, err := natsjwt.DecodeAccountClaims(value)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if claims.Limits.LeafNodeConn <= 0 {
+							return fmt.Errorf("expected positive leaf_node_conn, got %d", claims.Limits.LeafNodeConn)
+						}
+						if claims.Limits.IsJSEnabled() {
+							return fmt.Errorf("expected JetStream to be disabled, got %+v", claims.Limits.JetStreamLimits)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("data.natsjwt_leafnode_account.test", "user_creds", func(value string) error {
+						jwtString, err := natsjwt.ParseDecoratedJWT([]byte(value))
+						if err != nil {
+							return fmt.Errorf("failed to parse JWT from creds: %w", err)
+						}
+						claims, err := natsjwt.DecodeUserClaims(jwtString)
+						if err != nil {
+							return fmt.Errorf("failed to decode user JWT: %w", err)
+						}
+						if claims.Name != "leaf" {
+							return fmt.Errorf("expected user name leaf, got %q", claims.Name)
+						}
+						if claims.Issuer != acctPub {
+							return fmt.Errorf("expected user to be issued by %s, got %s", acctPub, claims.Issuer)
+						}
+						if !claims.AllowedConnectionTypes.Contains(natsjwt.ConnectionTypeLeafnode) || len(claims.AllowedConnectionTypes) != 1 {
+							return fmt.Errorf("expected only LEAFNODE connections, got %v", claims.AllowedConnectionTypes)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLeafnodeAccountDataSource_InvalidLeafNodeConn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_leafnode_account" "test" {
+  name           = "edge"
+  seed           = %q
+  operator_seed  = %q
+  user_seed      = %q
+  leaf_node_conn = 0
+}
+`, testAccountSeed(t), testOperatorSeed(t), testUserSeed(t)),
+				ExpectError: regexp.MustCompile(`Invalid Leaf Node Connections`),
+			},
+		},
+	})
+}
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+var _ datasource.DataSource = &MemoryResolverDataSource{}
+var _ datasource.DataSourceWithConfigure = &MemoryResolverDataSource{}
+
+type MemoryResolverDataSource struct {
+	jwtSettings
+}
+
+type MemoryResolverDataSourceModel struct {
+	OperatorName          types.String `tfsdk:"operator_name"`
+	OperatorSeed          types.String `tfsdk:"operator_seed"`
+	SystemAccountSeed     types.String `tfsdk:"system_account_seed"`
+	DisableDefaultExports types.Bool   `tfsdk:"disable_default_exports"`
+	Accounts              types.List   `tfsdk:"accounts"`
+	OperatorJWT           types.String `tfsdk:"operator_jwt"`
+	SystemAccountJWT      types.String `tfsdk:"system_account_jwt"`
+	AccountJWTs           types.Map    `tfsdk:"account_jwts"`
+	UserCreds             types.Map    `tfsdk:"user_creds"`
+	CredsBundle           types.String `tfsdk:"creds_bundle"`
+	ServerConfig          types.String `tfsdk:"server_config"`
+}
+
+type MemoryResolverAccountModel struct {
+	Name  types.String `tfsdk:"name"`
+	Seed  types.String `tfsdk:"seed"`
+	Users types.List   `tfsdk:"users"`
+}
+
+type MemoryResolverUserModel struct {
+	Name types.String `tfsdk:"name"`
+	Seed types.String `tfsdk:"seed"`
+}
+
+func NewMemoryResolverDataSource() datasource.DataSource {
+	return &MemoryResolverDataSource{}
+}
+
+func (d *MemoryResolverDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_memory_resolver"
+}
+
+func (d *MemoryResolverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Builds a complete operator, system account, account and user chain from seeds and renders a memory resolver server config for it. Intended for bootstrapping development servers.",
+		Attributes: map[string]schema.Attribute{
+			"operator_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Operator name.",
+				Validators:  []validator.String{NameValidator()},
+			},
+			"operator_seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Operator NKey seed (starts with SO).",
+				Validators:  []validator.String{SeedTypeValidator(nkeys.PrefixByteOperator)},
+			},
+			"system_account_seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "System account NKey seed (starts with SA). The account is named SYS.",
+				Validators:  []validator.String{SeedTypeValidator(nkeys.PrefixByteAccount)},
+			},
+			"disable_default_exports": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Do not add the default account monitoring exports to the SYS account. Default false.",
+			},
+			"accounts": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Accounts to create and preload.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Account name.",
+							Validators:  []validator.String{NameValidator()},
+						},
+						"seed": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "Account NKey seed (starts with SA).",
+							Validators:  []validator.String{SeedTypeValidator(nkeys.PrefixByteAccount)},
+						},
+						"users": schema.ListNestedAttribute{
+							Optional:    true,
+							Description: "Users to create in this account.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "User name.",
+										Validators:  []validator.String{NameValidator()},
+									},
+									"seed": schema.StringAttribute{
+										Required:    true,
+										Sensitive:   true,
+										Description: "User NKey seed (starts with SU).",
+										Validators:  []validator.String{SeedTypeValidator(nkeys.PrefixByteUser)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operator_jwt": schema.StringAttribute{
+				Computed:    true,
+				Description: "The signed operator JWT.",
+			},
+			"system_account_jwt": schema.StringAttribute{
+				Computed:    true,
+				Description: "The signed system account JWT.",
+			},
+			"account_jwts": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of account name to signed account JWT.",
+			},
+			"user_creds": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Map of <account name>/<user name> to NATS credentials file content.",
+			},
+			"creds_bundle": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "JSON object mapping each user public key to its credentials file content, for feeding a secrets manager.",
+			},
+			"server_config": schema.StringAttribute{
+				Computed:    true,
+				Description: "NATS server configuration using the memory resolver with all accounts preloaded.",
+			},
+		},
+	}
+}
+
+func (d *MemoryResolverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MemoryResolverDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accounts []MemoryResolverAccountModel
+	if !data.Accounts.IsNull() {
+		resp.Diagnostics.Append(data.Accounts.ElementsAs(ctx, &accounts, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	operatorKP, err := keypairFromSeed(data.OperatorSeed.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Operator Seed", fmt.Sprintf("Failed to parse operator seed: %s", err))
+		return
+	}
+
+	operatorPub, err := operatorKP.PublicKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Public Key Error", fmt.Sprintf("Failed to get operator public key: %s", err))
+		return
+	}
+
+	// System account, built like natsjwt_system_account with no extra configuration
+	sysClaims, sysPub, err := buildAccountClaims(ctx, &AccountDataSourceModel{
+		Name: types.StringValue("SYS"),
+		Seed: data.SystemAccountSeed,
+	}, d.issuedAtRoundTo, resp)
+	if err != nil || resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.DisableDefaultExports.ValueBool() {
+		applySystemAccountDefaults(sysClaims, defaultExportInfo{})
+	}
+
+	sysJWT, err := encodeDeterministic(sysClaims, operatorKP)
+	if err != nil {
+		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode system account JWT: %s", err))
+		return
+	}
+	d.checkJWTSize("system account", sysJWT, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operatorClaims := natsjwt.NewOperatorClaims(operatorPub)
+	operatorClaims.Name = data.OperatorName.ValueString()
+	operatorClaims.SystemAccount = sysPub
+	applyTemporalClaimsDefaults(operatorClaims.Claims(), types.Int64Null(), types.Int64Null(), types.Int64Null())
+
+	operatorJWT, err := encodeDeterministic(operatorClaims, operatorKP)
+	if err != nil {
+		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode operator JWT: %s", err))
+		return
+	}
+	d.checkJWTSize("operator", operatorJWT, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountJWTs := make(map[string]string, len(accounts))
+	accountJWTList := make([]string, 0, len(accounts))
+	userCreds := make(map[string]string)
+	credsBundle := make(map[string]string)
+	for _, acct := range accounts {
+		name := acct.Name.ValueString()
+		if _, ok := accountJWTs[name]; ok {
+			resp.Diagnostics.AddError("Duplicate Account Name", fmt.Sprintf("Account name %q is listed more than once", name))
+			return
+		}
+
+		claims, _, err := buildAccountClaims(ctx, &AccountDataSourceModel{
+			Name: acct.Name,
+			Seed: acct.Seed,
+		}, d.issuedAtRoundTo, resp)
+		if err != nil || resp.Diagnostics.HasError() {
+			return
+		}
+
+		acctJWT, err := encodeDeterministic(claims, operatorKP)
+		if err != nil {
+			resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode account JWT for %q: %s", name, err))
+			return
+		}
+		d.checkJWTSize("account", acctJWT, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		accountJWTs[name] = acctJWT
+		accountJWTList = append(accountJWTList, acctJWT)
+
+		if acct.Users.IsNull() {
+			continue
+		}
+		var users []MemoryResolverUserModel
+		resp.Diagnostics.Append(acct.Users.ElementsAs(ctx, &users, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		accountKP, err := keypairFromSeed(acct.Seed.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Account Seed", fmt.Sprintf("Failed to parse account seed: %s", err))
+			return
+		}
+		for _, user := range users {
+			key := name + "/" + user.Name.ValueString()
+			if _, ok := userCreds[key]; ok {
+				resp.Diagnostics.AddError("Duplicate User Name", fmt.Sprintf("User %q is listed more than once", key))
+				return
+			}
+
+			// Users get no permissions or limits, built like natsjwt_user.
+			// Build into a scratch response so diagnostics can name the user.
+			userResp := &datasource.ReadResponse{}
+			userClaims, userPub, err := buildUserClaims(ctx, &UserDataSourceModel{
+				Name:        user.Name,
+				Seed:        user.Seed,
+				AccountSeed: acct.Seed,
+			}, d.issuedAtRoundTo, userResp)
+			appendUserDiagnostics(key, userResp.Diagnostics, &resp.Diagnostics)
+			if err != nil || resp.Diagnostics.HasError() {
+				return
+			}
+
+			userJWT, err := encodeDeterministic(userClaims, accountKP)
+			if err != nil {
+				resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode user JWT for %q: %s", key, err))
+				return
+			}
+			d.checkJWTSize(fmt.Sprintf("user %q", key), userJWT, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			creds, err := natsjwt.FormatUserConfig(userJWT, []byte(user.Seed.ValueString()))
+			if err != nil {
+				resp.Diagnostics.AddError("Credentials Encoding Error", fmt.Sprintf("Failed to encode credentials for user %q: %s", key, err))
+				return
+			}
+			if _, ok := credsBundle[userPub]; ok {
+				resp.Diagnostics.AddError("Duplicate User Seed", fmt.Sprintf("User %q reuses the seed of another user (%s)", key, userPub))
+				return
+			}
+			userCreds[key] = string(creds)
+			credsBundle[userPub] = string(creds)
+		}
+	}
+
+	cfg, diags := buildServerConfig(operatorJWT, sysJWT, accountJWTList, "")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundle, err := json.Marshal(credsBundle)
+	if err != nil {
+		resp.Diagnostics.AddError("Credentials Encoding Error", fmt.Sprintf("Failed to encode creds_bundle: %s", err))
+		return
+	}
+
+	accountJWTsTF, diags := types.MapValueFrom(ctx, types.StringType, accountJWTs)
+	resp.Diagnostics.Append(diags...)
+	userCredsTF, diags := types.MapValueFrom(ctx, types.StringType, userCreds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OperatorJWT = types.StringValue(operatorJWT)
+	data.SystemAccountJWT = types.StringValue(sysJWT)
+	data.AccountJWTs = accountJWTsTF
+	data.UserCreds = userCredsTF
+	data.CredsBundle = types.StringValue(string(bundle))
+	data.ServerConfig = types.StringValue(cfg.Config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
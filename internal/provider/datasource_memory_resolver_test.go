@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccMemoryResolverDataSource_Basic(t *testing.T) {
+	opSeed, opPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	sysSeed, sysPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	userSeed, userPub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+
+	config := fmt.Sprintf(`
+data "natsjwt_memory_resolver" "test" {
+  operator_name       = "dev"
+  operator_seed       = %q
+  system_account_seed = %q
+  accounts = [{
+    name = "app"
+    seed = %q
+    users = [{
+      name = "alice"
+      seed = %q
+    }]
+  }]
+}
+`, opSeed, sysSeed, acctSeed, userSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources["data.natsjwt_memory_resolver.test"].Primary.Attributes
+
+						opClaims, err := natsjwt.DecodeOperatorClaims(attrs["operator_jwt"])
+						if err != nil {
+							return fmt.Errorf("failed to decode operator JWT: %w", err)
+						}
+						if opClaims.Subject != opPub || opClaims.SystemAccount != sysPub {
+							return fmt.Errorf("unexpected operator claims: sub=%s system_account=%s", opClaims.Subject, opClaims.SystemAccount)
+						}
+
+						acctClaims, err := natsjwt.DecodeAccountClaims(attrs["account_jwts.app"])
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if acctClaims.Subject != acctPub || acctClaims.Issuer != opPub {
+							return fmt.Errorf("unexpected account claims: sub=%s iss=%s", acctClaims.Subject, acctClaims.Issuer)
+						}
+
+						cfg := attrs["server_config"]
+						if !strings.Contains(cfg, "operator: "+attrs["operator_jwt"]) {
+							return fmt.Errorf("server_config missing operator JWT:\n%s", cfg)
+						}
+						if !strings.Contains(cfg, fmt.Sprintf("  %s: %s\n", acctPub, attrs["account_jwts.app"])) {
+							return fmt.Errorf("server_config missing account preload:\n%s", cfg)
+						}
+						if !strings.Contains(cfg, "system_account: "+sysPub) {
+							return fmt.Errorf("server_config missing system_account:\n%s", cfg)
+						}
+
+						userJWT, err := natsjwt.ParseDecoratedJWT([]byte(attrs["user_creds.app/alice"]))
+						if err != nil {
+							return fmt.Errorf("failed to parse user creds: %w", err)
+						}
+						userClaims, err := natsjwt.DecodeUserClaims(userJWT)
+						if err != nil {
+							return fmt.Errorf("failed to decode user JWT: %w", err)
+						}
+						if userClaims.Subject != userPub || userClaims.Issuer != acctPub {
+							return fmt.Errorf("unexpected user claims: sub=%s iss=%s", userClaims.Subject, userClaims.Issuer)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryResolverDataSource_CredsBundle(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	sysSeed := testAccountSeed(t)
+	acctSeed := testAccountSeed(t)
+	aliceSeed, alicePub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+	bobSeed, bobPub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+
+	config := fmt.Sprintf(`
+data "natsjwt_memory_resolver" "test" {
+  operator_name       = "dev"
+  operator_seed       = %q
+  system_account_seed = %q
+  accounts = [{
+    name = "app"
+    seed = %q
+    users = [
+      { name = "alice", seed = %q },
+      { name = "bob", seed = %q },
+    ]
+  }]
+}
+`, opSeed, sysSeed, acctSeed, aliceSeed, bobSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					attrs := s.RootModule().Resources["data.natsjwt_memory_resolver.test"].Primary.Attributes
+
+					var bundle map[string]string
+					if err := json.Unmarshal([]byte(attrs["creds_bundle"]), &bundle); err != nil {
+						return fmt.Errorf("creds_bundle is not valid JSON: %w", err)
+					}
+					if len(bundle) != 2 {
+						return fmt.Errorf("expected 2 bundle entries, got %d", len(bundle))
+					}
+					for pub, name := range map[string]string{alicePub: "alice", bobPub: "bob"} {
+						if bundle[pub] != attrs["user_creds.app/"+name] {
+							return fmt.Errorf("bundle entry for %s does not match user_creds for %s", pub, name)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccMemoryResolverDataSource_SystemAccountExports(t *testing.T) {
+	config := func(disable bool) string {
+		return fmt.Sprintf(`
+data "natsjwt_memory_resolver" "test" {
+  operator_name           = "dev"
+  operator_seed           = %q
+  system_account_seed     = %q
+  disable_default_exports = %t
+}
+`, testOperatorSeed(t), testAccountSeed(t), disable)
+	}
+
+	sysExports := func(want int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			attrs := s.RootModule().Resources["data.natsjwt_memory_resolver.test"].Primary.Attributes
+			claims, err := natsjwt.DecodeAccountClaims(attrs["system_account_jwt"])
+			if err != nil {
+				return fmt.Errorf("failed to decode system account JWT: %w", err)
+			}
+			if len(claims.Exports) != want {
+				return fmt.Errorf("expected %d exports, got %d", want, len(claims.Exports))
+			}
+			for _, exp := range claims.Exports {
+				if exp.Subject == "$SYS.REQ.ACCOUNT.*.*" && exp.Type == natsjwt.Service {
+					return nil
+				}
+			}
+			if want > 0 {
+				return fmt.Errorf("expected $SYS.REQ.ACCOUNT.*.* service export, got %v", claims.Exports)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false),
+				Check:  sysExports(2),
+			},
+			{
+				Config: config(true),
+				Check:  sysExports(0),
+			},
+		},
+	})
+}
+
+func TestAccMemoryResolverDataSource_InvalidName(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_memory_resolver" "test" {
+  operator_name       = "dev"
+  operator_seed       = %q
+  system_account_seed = %q
+  accounts = [{
+    name = "app"
+    seed = %q
+    users = [{
+      name = ""
+      seed = %q
+    }]
+  }]
+}
+`, testOperatorSeed(t), testAccountSeed(t), testAccountSeed(t), testUserSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Name`),
+			},
+		},
+	})
+}
+
+func TestAccMemoryResolverDataSource_UserMatchesUserDataSource(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_memory_resolver" "test" {
+  operator_name       = "dev"
+  operator_seed       = %q
+  system_account_seed = %q
+  accounts = [{
+    name = "app"
+    seed = %[3]q
+    users = [{
+      name = "alice"
+      seed = %[4]q
+    }]
+  }]
+}
+
+data "natsjwt_user" "alice" {
+  name         = "alice"
+  seed         = %[4]q
+  account_seed = %[3]q
+}
+`, testOperatorSeed(t), testAccountSeed(t), acctSeed, userSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					resolver := s.RootModule().Resources["data.natsjwt_memory_resolver.test"].Primary.Attributes
+					user := s.RootModule().Resources["data.natsjwt_user.alice"].Primary.Attributes
+					if resolver["user_creds.app/alice"] != user["creds"] {
+						return fmt.Errorf("memory resolver creds differ from natsjwt_user creds:\n%s\n%s", resolver["user_creds.app/alice"], user["creds"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nats-io/nkeys"
+)
+
+var _ datasource.DataSource = &NkeyDataSource{}
+
+type NkeyDataSource struct{}
+
+type NkeyDataSourceModel struct {
+	Seed                types.String `tfsdk:"seed"`
+	PublicKey           types.String `tfsdk:"public_key"`
+	Type                types.String `tfsdk:"type"`
+	IsSigningKeyCapable types.Bool   `tfsdk:"is_signing_key_capable"`
+}
+
+func NewNkeyDataSource() datasource.DataSource {
+	return &NkeyDataSource{}
+}
+
+func (d *NkeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nkey"
+}
+
+func (d *NkeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Derives the public key and key type from an existing NKey seed.",
+		Attributes: map[string]schema.Attribute{
+			"seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "NKey seed to inspect.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key matching the seed.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Key type: operator, account, user, server or unknown.",
+			},
+			"is_signing_key_capable": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True for operator and account keys, which can be used as signing keys.",
+			},
+		},
+	}
+}
+
+func (d *NkeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NkeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seed := data.Seed.ValueString()
+	kp, err := keypairFromSeed(seed)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Seed", err.Error())
+		return
+	}
+
+	pub, err := kp.PublicKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Public Key Error", fmt.Sprintf("Failed to get public key: %s", err))
+		return
+	}
+
+	prefix, _, err := nkeys.DecodeSeed([]byte(seed))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Seed", fmt.Sprintf("Failed to decode seed: %s", err))
+		return
+	}
+
+	data.PublicKey = types.StringValue(pub)
+	data.Type = types.StringValue(prefixName(prefix))
+	data.IsSigningKeyCapable = types.BoolValue(prefix == nkeys.PrefixByteOperator || prefix == nkeys.PrefixByteAccount)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
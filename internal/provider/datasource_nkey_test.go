@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccNkeyDataSource_Operator(t *testing.T) {
+	seed, pub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_nkey" "test" {
+  seed = %q
+}
+`, seed),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_nkey.test", "type", "operator"),
+					resource.TestCheckResourceAttr("data.natsjwt_nkey.test", "public_key", pub),
+					resource.TestMatchResourceAttr("data.natsjwt_nkey.test", "public_key", regexp.MustCompile(`^O`)),
+					resource.TestCheckResourceAttr("data.natsjwt_nkey.test", "is_signing_key_capable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNkeyDataSource_User(t *testing.T) {
+	seed, pub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_nkey" "test" {
+  seed = %q
+}
+`, seed),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_nkey.test", "type", "user"),
+					resource.TestCheckResourceAttr("data.natsjwt_nkey.test", "public_key", pub),
+					resource.TestCheckResourceAttr("data.natsjwt_nkey.test", "is_signing_key_capable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNkeyDataSource_InvalidSeed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "natsjwt_nkey" "test" {
+  seed = "not-a-seed"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Seed`),
+			},
+		},
+	})
+}
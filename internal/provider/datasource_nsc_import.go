@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ datasource.DataSource = &NscImportDataSource{}
+
+type NscImportDataSource struct{}
+
+type NscImportDataSourceModel struct {
+	NscJSON   types.String `tfsdk:"nsc_json"`
+	Operators types.Map    `tfsdk:"operators"`
+	Accounts  types.Map    `tfsdk:"accounts"`
+	Users     types.Map    `tfsdk:"users"`
+	JWTs      types.Map    `tfsdk:"jwts"`
+}
+
+func NewNscImportDataSource() datasource.DataSource {
+	return &NscImportDataSource{}
+}
+
+func (d *NscImportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nsc_import"
+}
+
+func (d *NscImportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads entities exported from nsc and lists the operators, accounts and users they describe, for migrating an nsc setup to this provider.",
+		Attributes: map[string]schema.Attribute{
+			"nsc_json": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON output of nsc describe --json for an operator, account or user, or a JSON array of several. Array elements may also be encoded JWTs as printed by nsc describe --raw.",
+			},
+			"operators": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of operator name to operator public key.",
+			},
+			"accounts": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of account name to account public key.",
+			},
+			"users": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of <account name>/<user name> to user public key. The account public key stands in for the account name when the account is not part of the input.",
+			},
+			"jwts": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of public key to JWT for the entities given as encoded JWTs.",
+			},
+		},
+	}
+}
+
+func (d *NscImportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NscImportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entities, err := parseNscExport(data.NscJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("nsc_json"), "Invalid NSC JSON", err.Error())
+		return
+	}
+
+	operators := map[string]string{}
+	accounts := map[string]string{}
+	accountNames := map[string]string{}
+	jwts := map[string]string{}
+	var users []nscEntity
+	add := func(kind string, names map[string]string, e nscEntity) {
+		if existing, ok := names[e.claims.Name]; ok && existing != e.claims.Subject {
+			resp.Diagnostics.AddAttributeError(path.Root("nsc_json"), "Duplicate Name",
+				fmt.Sprintf("%s name %q is used by both %s and %s", kind, e.claims.Name, existing, e.claims.Subject))
+			return
+		}
+		names[e.claims.Name] = e.claims.Subject
+	}
+	for _, e := range entities {
+		switch e.claims.ClaimType() {
+		case natsjwt.OperatorClaim:
+			add("Operator", operators, e)
+		case natsjwt.AccountClaim:
+			add("Account", accounts, e)
+			accountNames[e.claims.Subject] = e.claims.Name
+		case natsjwt.UserClaim:
+			users = append(users, e)
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("nsc_json"), "Unsupported Claim Type",
+				fmt.Sprintf("Entity %q (%s) has claim type %q; only operator, account and user claims are supported", e.claims.Name, e.claims.Subject, e.claims.ClaimType()))
+		}
+		if e.jwt != "" {
+			jwts[e.claims.Subject] = e.jwt
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Users are keyed by account name, which is only known once all accounts are read.
+	userKeys := map[string]string{}
+	for _, e := range users {
+		account := e.claims.Issuer
+		if issuerAccount, ok := e.claims.Data["issuer_account"].(string); ok && issuerAccount != "" {
+			account = issuerAccount
+		}
+		if name, ok := accountNames[account]; ok {
+			account = name
+		}
+		key := account + "/" + e.claims.Name
+		if existing, ok := userKeys[key]; ok && existing != e.claims.Subject {
+			resp.Diagnostics.AddAttributeError(path.Root("nsc_json"), "Duplicate Name",
+				fmt.Sprintf("User name %q is used by both %s and %s", key, existing, e.claims.Subject))
+			return
+		}
+		userKeys[key] = e.claims.Subject
+	}
+
+	var diags diag.Diagnostics
+	data.Operators, diags = types.MapValueFrom(ctx, types.StringType, operators)
+	resp.Diagnostics.Append(diags...)
+	data.Accounts, diags = types.MapValueFrom(ctx, types.StringType, accounts)
+	resp.Diagnostics.Append(diags...)
+	data.Users, diags = types.MapValueFrom(ctx, types.StringType, userKeys)
+	resp.Diagnostics.Append(diags...)
+	data.JWTs, diags = types.MapValueFrom(ctx, types.StringType, jwts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// nscEntity is one operator, account or user read from an nsc export. jwt is
+// set only when the entity was given as an encoded JWT.
+type nscEntity struct {
+	claims *natsjwt.GenericClaims
+	jwt    string
+}
+
+// parseNscExport reads the output of nsc describe --json, either a single
+// claims object or an array whose elements are claims objects or encoded JWTs.
+// Encoded JWTs are decoded, which verifies their signatures.
+func parseNscExport(export string) ([]nscEntity, error) {
+	var raw json.RawMessage
+	if err := json.Unmarshal([]byte(export), &raw); err != nil {
+		return nil, fmt.Errorf("nsc_json is not valid JSON: %w", err)
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(raw, &elements); err != nil {
+		elements = []json.RawMessage{raw}
+	}
+
+	entities := make([]nscEntity, 0, len(elements))
+	for i, element := range elements {
+		var token string
+		if err := json.Unmarshal(element, &token); err == nil {
+			claims, err := natsjwt.DecodeGeneric(token)
+			if err != nil {
+				return nil, fmt.Errorf("element %d is not a valid JWT: %w", i, err)
+			}
+			entities = append(entities, nscEntity{claims: claims, jwt: token})
+			continue
+		}
+
+		var claims natsjwt.GenericClaims
+		if err := json.Unmarshal(element, &claims); err != nil {
+			return nil, fmt.Errorf("element %d is neither a claims object nor a JWT: %w", i, err)
+		}
+		if claims.Subject == "" {
+			return nil, fmt.Errorf("element %d has no sub field", i)
+		}
+		entities = append(entities, nscEntity{claims: &claims})
+	}
+	return entities, nil
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccNscImportDataSource_Basic(t *testing.T) {
+	opSeed, opPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	_, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, userAcctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, userPub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+
+	opKP, err := nkeys.FromSeed([]byte(opSeed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	acctClaims := natsjwt.NewAccountClaims(userAcctPub)
+	acctClaims.Name = "APP"
+	acctJWT, err := acctClaims.Encode(opKP)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Shaped like the output of nsc describe operator --json and nsc describe
+	// user --json, plus an account given as nsc describe account --raw prints it.
+	export, err := json.Marshal([]interface{}{
+		map[string]interface{}{
+			"iat":  1700000000,
+			"iss":  opPub,
+			"jti":  "OPERATORJTI",
+			"name": "nsc-op",
+			"sub":  opPub,
+			"nats": map[string]interface{}{"type": "operator", "version": 2},
+		},
+		map[string]interface{}{
+			"iat":  1700000000,
+			"iss":  opPub,
+			"jti":  "ACCOUNTJTI",
+			"name": "SYS",
+			"sub":  acctPub,
+			"nats": map[string]interface{}{"type": "account", "version": 2},
+		},
+		acctJWT,
+		map[string]interface{}{
+			"iat":  1700000000,
+			"iss":  userAcctPub,
+			"jti":  "USERJTI",
+			"name": "alice",
+			"sub":  userPub,
+			"nats": map[string]interface{}{"type": "user", "version": 2},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_nsc_import" "test" {
+  nsc_json = %q
+}
+`, string(export)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "operators.%", "1"),
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "operators.nsc-op", opPub),
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "accounts.%", "2"),
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "accounts.SYS", acctPub),
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "accounts.APP", userAcctPub),
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "users.APP/alice", userPub),
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "jwts.%", "1"),
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "jwts."+userAcctPub, acctJWT),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNscImportDataSource_SingleObject(t *testing.T) {
+	_, opPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_nsc_import" "test" {
+  nsc_json = jsonencode({
+    iat  = 1700000000
+    iss  = %[1]q
+    jti  = "OPERATORJTI"
+    name = "solo"
+    sub  = %[1]q
+    nats = { type = "operator", version = 2 }
+  })
+}
+`, opPub),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "operators.solo", opPub),
+					resource.TestCheckResourceAttr("data.natsjwt_nsc_import.test", "accounts.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNscImportDataSource_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "natsjwt_nsc_import" "test" {
+  nsc_json = "not json"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid NSC JSON`),
+			},
+			{
+				Config: `
+data "natsjwt_nsc_import" "test" {
+  nsc_json = jsonencode(["not-a-jwt"])
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid NSC JSON`),
+			},
+		},
+	})
+}
@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	natsjwt "github.com/nats-io/jwt/v2"
@@ -13,8 +15,12 @@ import (
 )
 
 var _ datasource.DataSource = &OperatorDataSource{}
+var _ datasource.DataSourceWithConfigure = &OperatorDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &OperatorDataSource{}
 
-type OperatorDataSource struct{}
+type OperatorDataSource struct {
+	jwtSettings
+}
 
 type OperatorDataSourceModel struct {
 	Name                  types.String `tfsdk:"name"`
@@ -25,11 +31,17 @@ type OperatorDataSourceModel struct {
 	SystemAccount         types.String `tfsdk:"system_account"`
 	StrictSigningKeyUsage types.Bool   `tfsdk:"strict_signing_key_usage"`
 	IssuedAt              types.Int64  `tfsdk:"issued_at"`
+	IssuedAtRFC3339       types.String `tfsdk:"issued_at_rfc3339"`
 	Expires               types.Int64  `tfsdk:"expires"`
+	MinValidity           types.String `tfsdk:"min_validity"`
+	ReferenceUnix         types.Int64  `tfsdk:"reference_unix"`
 	NotBefore             types.Int64  `tfsdk:"not_before"`
 	Tags                  types.List   `tfsdk:"tags"`
+	OmitEmptyFields       types.Bool   `tfsdk:"omit_empty_fields"`
 	PublicKey             types.String `tfsdk:"public_key"`
 	JWT                   types.String `tfsdk:"jwt"`
+	Signature             types.String `tfsdk:"signature"`
+	OperatorDecorated     types.String `tfsdk:"operator_decorated"`
 }
 
 func NewOperatorDataSource() datasource.DataSource {
@@ -46,7 +58,8 @@ func (d *OperatorDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Operator name.",
+				Description: "Operator name. Must be non-empty, without leading or trailing whitespace, and at most 256 characters.",
+				Validators:  []validator.String{NameValidator()},
 			},
 			"seed": schema.StringAttribute{
 				Required:    true,
@@ -58,19 +71,23 @@ func (d *OperatorDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				ElementType: types.StringType,
 				Optional:    true,
 				Description: "Additional signing key public keys.",
+				Validators:  []validator.List{PublicKeyListValidator(nkeys.PrefixByteOperator)},
 			},
 			"account_server_url": schema.StringAttribute{
 				Optional:    true,
-				Description: "Account server URL.",
+				Description: "Account server URL, e.g. https://host/jwt/v1. Must use http, https, nats, tls, ws or wss and name a host.",
+				Validators:  []validator.String{AccountServerURLValidator()},
 			},
 			"operator_service_urls": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
-				Description: "Operator service URLs.",
+				Description: "Operator service URLs, e.g. nats://localhost:4222. Each must use nats, tls, ws or wss, name a host and have no credentials or path.",
+				Validators:  []validator.List{NatsURLValidator()},
 			},
 			"system_account": schema.StringAttribute{
 				Optional:    true,
 				Description: "Public key of the system account.",
+				Validators:  []validator.String{PublicKeyTypeValidator(nkeys.PrefixByteAccount)},
 			},
 			"strict_signing_key_usage": schema.BoolAttribute{
 				Optional:    true,
@@ -80,10 +97,22 @@ func (d *OperatorDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Optional:    true,
 				Description: "JWT issued-at timestamp as Unix seconds. Defaults to 0 (Unix epoch).",
 			},
+			"issued_at_rfc3339": schema.StringAttribute{
+				Optional:    true,
+				Description: "JWT issued-at timestamp in RFC 3339 format, e.g. a commit time such as 2023-01-01T00:00:00Z. Alternative to issued_at; setting both is an error.",
+			},
 			"expires": schema.Int64Attribute{
 				Optional:    true,
 				Description: "JWT expiration timestamp as Unix seconds. Defaults to no expiration.",
 			},
+			"min_validity": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum time the JWT must remain valid after reference_unix, as a Go duration such as 1h. Reading fails when expires is sooner. Ignored when expires is not set.",
+			},
+			"reference_unix": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Unix timestamp min_validity is measured from. Defaults to the current time.",
+			},
 			"not_before": schema.Int64Attribute{
 				Optional:    true,
 				Description: "JWT not-before timestamp as Unix seconds. Defaults to issued_at.",
@@ -93,6 +122,10 @@ func (d *OperatorDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Optional:    true,
 				Description: "Tags for the operator.",
 			},
+			"omit_empty_fields": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Omit zero-valued optional claim fields from the JWT payload, as the nats jwt library does. Default true. Set to false to write explicit zero values for servers that expect every field to be present.",
+			},
 			"public_key": schema.StringAttribute{
 				Computed:    true,
 				Description: "The operator's public key.",
@@ -101,10 +134,33 @@ func (d *OperatorDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:    true,
 				Description: "The signed operator JWT.",
 			},
+			"signature": schema.StringAttribute{
+				Computed:    true,
+				Description: "Signature segment of the operator JWT (the part after the last dot), base64url encoded without padding. It signs the header and payload segments joined by a dot.",
+			},
+			"operator_decorated": schema.StringAttribute{
+				Computed:    true,
+				Description: "The operator JWT in decorated form (-----BEGIN NATS OPERATOR JWT-----), as written to operator files referenced by the server config.",
+			},
 		},
 	}
 }
 
+// ValidateConfig warns when the operator has no system account. The JWT is
+// still generated, since some deployments add the system account later.
+func (d *OperatorDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var systemAccount types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("system_account"), &systemAccount)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if systemAccount.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(path.Root("system_account"), "Missing System Account",
+			"system_account is not set. nats-server needs a system account for account resolution, monitoring and JWT updates; set it to the public key of a natsjwt_system_account.")
+	}
+}
+
 func (d *OperatorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data OperatorDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -133,6 +189,8 @@ func (d *OperatorDataSource) Read(ctx context.Context, req datasource.ReadReques
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		// Sort so reordering signing_keys in HCL does not change the JWT.
+		sort.Strings(signingKeys)
 		for _, sk := range signingKeys {
 			claims.SigningKeys.Add(sk)
 		}
@@ -158,7 +216,15 @@ func (d *OperatorDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if !data.StrictSigningKeyUsage.IsNull() {
 		claims.StrictSigningKeyUsage = data.StrictSigningKeyUsage.ValueBool()
 	}
-	applyTemporalClaimsDefaults(claims.Claims(), data.IssuedAt, data.Expires, data.NotBefore)
+	issuedAt := resolveIssuedAt(data.IssuedAt, data.IssuedAtRFC3339, d.issuedAtRoundTo, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	applyTemporalClaimsDefaults(claims.Claims(), issuedAt, data.Expires, data.NotBefore)
+	checkMinValidity(data.Expires, data.MinValidity, data.ReferenceUnix, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if !data.Tags.IsNull() {
 		var tags []string
@@ -169,14 +235,27 @@ func (d *OperatorDataSource) Read(ctx context.Context, req datasource.ReadReques
 		claims.Tags = tags
 	}
 
-	jwtString, err := encodeDeterministic(claims, kp)
+	jwtString, err := encodeDeterministicWithOptions(claims, kp, nil, omitEmptyFields(data.OmitEmptyFields))
 	if err != nil {
 		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode operator JWT: %s", err))
 		return
 	}
 
+	d.checkJWTSize("operator", jwtString, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	decorated, err := natsjwt.DecorateJWT(jwtString)
+	if err != nil {
+		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to decorate operator JWT: %s", err))
+		return
+	}
+
 	data.PublicKey = types.StringValue(pub)
 	data.JWT = types.StringValue(jwtString)
+	data.Signature = types.StringValue(jwtSignature(jwtString))
+	data.OperatorDecorated = types.StringValue(string(decorated))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	natsjwt "github.com/nats-io/jwt/v2"
@@ -146,6 +150,72 @@ data "natsjwt_operator" "test" {
 	})
 }
 
+func TestAccOperatorDataSource_WildcardSystemAccount(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "test" {
+  name           = "test-op"
+  seed           = %q
+  system_account = "*"
+}
+`, testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Wildcard Public Key Not Allowed`),
+			},
+		},
+	})
+}
+
+func TestAccOperatorDataSource_InvalidServiceURL(t *testing.T) {
+	config := func(serviceURL string) string {
+		return fmt.Sprintf(`
+data "natsjwt_operator" "test" {
+  name                  = "test-op"
+  seed                  = %q
+  operator_service_urls = [%q]
+}
+`, testOperatorSeed(t), serviceURL)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("http://x"),
+				ExpectError: regexp.MustCompile(`Invalid NATS URL`),
+			},
+			{
+				Config: config("nats://localhost:4222"),
+				Check:  resource.TestCheckResourceAttrSet("data.natsjwt_operator.test", "jwt"),
+			},
+		},
+	})
+}
+
+func TestAccOperatorDataSource_WildcardSigningKey(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "test" {
+  name         = "test-op"
+  seed         = %q
+  signing_keys = ["*"]
+}
+`, testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Wildcard Public Key Not Allowed`),
+			},
+		},
+	})
+}
+
 func TestAccOperatorDataSource_Stability(t *testing.T) {
 	seed := testOperatorSeed(t)
 	config := fmt.Sprintf(`
@@ -175,6 +245,39 @@ data "natsjwt_operator" "test" {
 	})
 }
 
+func TestAccOperatorDataSource_Decorated(t *testing.T) {
+	seed := testOperatorSeed(t)
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "test" {
+  name = "decorated-op"
+  seed = %q
+}
+`, seed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.natsjwt_operator.test", "operator_decorated", regexp.MustCompile(`-----BEGIN NATS OPERATOR JWT-----`)),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["data.natsjwt_operator.test"]
+						parsed, err := natsjwt.ParseDecoratedJWT([]byte(rs.Primary.Attributes["operator_decorated"]))
+						if err != nil {
+							return fmt.Errorf("parsing decorated JWT: %w", err)
+						}
+						if parsed != rs.Primary.Attributes["jwt"] {
+							return fmt.Errorf("decorated JWT does not match jwt attribute")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 // Helper to capture JWT value from state
 func captureJWT(resourceName string, target *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -215,3 +318,78 @@ func testCheckJWTField(resourceName string, check func(string) error) resource.T
 		return check(jwtStr)
 	}
 }
+
+func TestAccOperatorDataSource_SigningKeyOrder(t *testing.T) {
+	seed := testOperatorSeed(t)
+	_, sk1 := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	_, sk2 := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+
+	config := func(first, second string) string {
+		return fmt.Sprintf(`
+data "natsjwt_operator" "test" {
+  name         = "ordered-op"
+  seed         = %q
+  signing_keys = [%q, %q]
+}
+`, seed, first, second)
+	}
+
+	var firstJWT string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(sk1, sk2),
+				Check:  captureJWT("data.natsjwt_operator.test", &firstJWT),
+			},
+			{
+				Config: config(sk2, sk1),
+				Check:  compareJWT("data.natsjwt_operator.test", &firstJWT),
+			},
+		},
+	})
+}
+
+func TestOperatorDataSource_ValidateConfigSystemAccount(t *testing.T) {
+	ctx := context.Background()
+	ds := &OperatorDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := func(systemAccount interface{}) tfsdk.Config {
+		values := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+		for name, typ := range objType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		values["name"] = tftypes.NewValue(tftypes.String, "op")
+		values["system_account"] = tftypes.NewValue(tftypes.String, systemAccount)
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)}
+	}
+
+	// The warning is advisory: TestAccOperatorDataSource_Basic still gets a JWT
+	// without a system account.
+	_, sysPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	tests := map[string]struct {
+		systemAccount interface{}
+		wantWarning   bool
+	}{
+		"unset":   {systemAccount: nil, wantWarning: true},
+		"set":     {systemAccount: sysPub, wantWarning: false},
+		"unknown": {systemAccount: tftypes.UnknownValue, wantWarning: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &datasource.ValidateConfigResponse{}
+			ds.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: config(tc.systemAccount)}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.wantWarning {
+				t.Fatalf("expected warning %t, got diagnostics %v", tc.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
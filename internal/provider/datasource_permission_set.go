@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PermissionSetDataSource{}
+
+type PermissionSetDataSource struct{}
+
+type PermissionSetDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	PubAllow    types.List   `tfsdk:"pub_allow"`
+	PubDeny     types.List   `tfsdk:"pub_deny"`
+	SubAllow    types.List   `tfsdk:"sub_allow"`
+	SubDeny     types.List   `tfsdk:"sub_deny"`
+	Permissions types.Object `tfsdk:"permissions"`
+}
+
+// userPermissionsAttrTypes mirrors the permissions attribute of natsjwt_user so the
+// computed object can be assigned to it directly.
+var userPermissionsAttrTypes = map[string]attr.Type{
+	"pub_allow":     types.ListType{ElemType: types.StringType},
+	"pub_deny":      types.ListType{ElemType: types.StringType},
+	"sub_allow":     types.ListType{ElemType: types.StringType},
+	"sub_deny":      types.ListType{ElemType: types.StringType},
+	"resp_max_msgs": types.Int64Type,
+	"resp_ttl":      types.StringType,
+	"resp_type":     types.StringType,
+}
+
+func NewPermissionSetDataSource() datasource.DataSource {
+	return &PermissionSetDataSource{}
+}
+
+func (d *PermissionSetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_set"
+}
+
+func (d *PermissionSetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	subjectList := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: description,
+			Validators:  []validator.List{SubjectListValidator()},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Defines a reusable, validated set of publish/subscribe permissions (a role) that can be assigned to the permissions attribute of natsjwt_user.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the permission set. Informational only.",
+			},
+			"pub_allow": subjectList("Subjects allowed for publishing."),
+			"pub_deny":  subjectList("Subjects denied for publishing."),
+			"sub_allow": subjectList("Subjects allowed for subscribing."),
+			"sub_deny":  subjectList("Subjects denied for subscribing."),
+			"permissions": schema.ObjectAttribute{
+				AttributeTypes: userPermissionsAttrTypes,
+				Computed:       true,
+				Description:    "Permissions object suitable for the permissions attribute of natsjwt_user.",
+			},
+		},
+	}
+}
+
+func (d *PermissionSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionSetDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := types.ObjectValue(userPermissionsAttrTypes, map[string]attr.Value{
+		"pub_allow":     data.PubAllow,
+		"pub_deny":      data.PubDeny,
+		"sub_allow":     data.SubAllow,
+		"sub_deny":      data.SubDeny,
+		"resp_max_msgs": types.Int64Null(),
+		"resp_ttl":      types.StringNull(),
+		"resp_type":     types.StringNull(),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Permissions = permissions
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+func TestAccPermissionSetDataSource_AppliedToUser(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_permission_set" "reader" {
+  name      = "reader"
+  pub_allow = ["_INBOX.>"]
+  sub_allow = ["orders.*", "events.>"]
+  sub_deny  = ["orders.secret"]
+}
+
+data "natsjwt_user" "test" {
+  name         = "reader-user"
+  seed         = %q
+  account_seed = %q
+  permissions  = data.natsjwt_permission_set.reader.permissions
+}
+`, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_permission_set.reader", "permissions.sub_allow.#", "2"),
+					resource.TestCheckResourceAttr("data.natsjwt_permission_set.reader", "permissions.sub_allow.0", "orders.*"),
+					resource.TestCheckResourceAttr("data.natsjwt_permission_set.reader", "permissions.pub_allow.0", "_INBOX.>"),
+					testCheckJWTField("data.natsjwt_user.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeUserClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode user JWT: %w", err)
+						}
+						if len(claims.Sub.Allow) != 2 || claims.Sub.Allow[1] != "events.>" {
+							return fmt.Errorf("unexpected sub_allow: %v", claims.Sub.Allow)
+						}
+						if len(claims.Sub.Deny) != 1 || len(claims.Pub.Allow) != 1 {
+							return fmt.Errorf("unexpected permissions: %+v", claims.Permissions)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPermissionSetDataSource_InvalidSubject(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "natsjwt_permission_set" "bad" {
+  pub_allow = ["orders.>.created"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Subject`),
+			},
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+var _ datasource.DataSource = &PruneRevocationsDataSource{}
+var _ datasource.DataSourceWithConfigure = &PruneRevocationsDataSource{}
+
+type PruneRevocationsDataSource struct {
+	jwtSettings
+}
+
+type PruneRevocationsDataSourceModel struct {
+	AccountJWT    types.String `tfsdk:"account_jwt"`
+	OperatorSeed  types.String `tfsdk:"operator_seed"`
+	MaxAge        types.String `tfsdk:"max_age"`
+	ReferenceUnix types.Int64  `tfsdk:"reference_unix"`
+	JWT           types.String `tfsdk:"jwt"`
+	Removed       types.Int64  `tfsdk:"removed"`
+}
+
+func NewPruneRevocationsDataSource() datasource.DataSource {
+	return &PruneRevocationsDataSource{}
+}
+
+func (d *PruneRevocationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prune_revocations"
+}
+
+func (d *PruneRevocationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Removes old user revocation entries from an account JWT and re-signs it.",
+		Attributes: map[string]schema.Attribute{
+			"account_jwt": schema.StringAttribute{
+				Required:    true,
+				Description: "Account JWT whose revocation list is pruned.",
+			},
+			"operator_seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Operator or signing key seed used to re-sign the account JWT (starts with SO).",
+				Validators:  []schemavalidator.String{SeedTypeValidator(nkeys.PrefixByteOperator)},
+			},
+			"max_age": schema.StringAttribute{
+				Required:    true,
+				Description: "Revocation entries whose timestamp is more than this long before reference_unix are removed. Go duration syntax, e.g. 720h.",
+			},
+			"reference_unix": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Unix timestamp the age of each entry is measured from. Defaults to the current time.",
+			},
+			"jwt": schema.StringAttribute{
+				Computed:    true,
+				Description: "The re-signed account JWT without the pruned entries.",
+			},
+			"removed": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of revocation entries removed.",
+			},
+		},
+	}
+}
+
+func (d *PruneRevocationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PruneRevocationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxAge, err := time.ParseDuration(data.MaxAge.ValueString())
+	if err != nil || maxAge < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_age"), "Invalid Max Age",
+			fmt.Sprintf("max_age must be a non-negative Go duration such as 720h, got %q", data.MaxAge.ValueString()))
+		return
+	}
+
+	claims, err := natsjwt.DecodeAccountClaims(data.AccountJWT.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("account_jwt"), "Invalid Account JWT", fmt.Sprintf("Failed to decode account JWT: %s", err))
+		return
+	}
+
+	operatorKP, err := keypairFromSeed(data.OperatorSeed.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Operator Seed", fmt.Sprintf("Failed to parse operator seed: %s", err))
+		return
+	}
+
+	reference := time.Now().Unix()
+	if !data.ReferenceUnix.IsNull() {
+		reference = data.ReferenceUnix.ValueInt64()
+	}
+	cutoff := reference - int64(maxAge/time.Second)
+
+	var removed int64
+	for pubKey, ts := range claims.Revocations {
+		if ts < cutoff {
+			delete(claims.Revocations, pubKey)
+			removed++
+		}
+	}
+	if len(claims.Revocations) == 0 {
+		claims.Revocations = nil
+	}
+	// The decoded ID is a hash of the old payload; drop it like the account
+	// data source does rather than carry a stale value.
+	claims.ID = ""
+
+	jwtString, err := encodeDeterministic(claims, operatorKP)
+	if err != nil {
+		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode account JWT: %s", err))
+		return
+	}
+
+	d.checkJWTSize("account", jwtString, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.JWT = types.StringValue(jwtString)
+	data.Removed = types.Int64Value(removed)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+// testAccountJWTWithRevocations signs an account JWT carrying the given user
+// revocations, which the account data source cannot produce.
+func testAccountJWTWithRevocations(t *testing.T, opSeed string, revocations natsjwt.RevocationList) string {
+	t.Helper()
+	opKP, err := keypairFromSeed(opSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	claims := natsjwt.NewAccountClaims(acctPub)
+	claims.Name = "app"
+	claims.Revocations = revocations
+	jwtStr, err := encodeDeterministic(claims, opKP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return jwtStr
+}
+
+func TestAccPruneRevocationsDataSource_RemovesOldEntries(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	_, oldUser := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+	_, recentUser := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+	acctJWT := testAccountJWTWithRevocations(t, opSeed, natsjwt.RevocationList{
+		oldUser:    100,
+		recentUser: 900,
+	})
+
+	config := fmt.Sprintf(`
+data "natsjwt_prune_revocations" "test" {
+  account_jwt    = %q
+  operator_seed  = %q
+  max_age        = "5m"
+  reference_unix = 1000
+}
+`, acctJWT, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_prune_revocations.test", "removed", "1"),
+					testCheckJWTField("data.natsjwt_prune_revocations.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode account JWT: %w", err)
+						}
+						if _, ok := claims.Revocations[oldUser]; ok {
+							return fmt.Errorf("expected entry for %s older than max_age to be removed", oldUser)
+						}
+						if ts, ok := claims.Revocations[recentUser]; !ok || ts != 900 {
+							return fmt.Errorf("expected entry for %s to remain at 900, got %v", recentUser, claims.Revocations)
+						}
+						if claims.Name != "app" {
+							return fmt.Errorf("expected name to be preserved, got %q", claims.Name)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPruneRevocationsDataSource_InvalidMaxAge(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctJWT := testAccountJWTWithRevocations(t, opSeed, nil)
+
+	config := fmt.Sprintf(`
+data "natsjwt_prune_revocations" "test" {
+  account_jwt   = %q
+  operator_seed = %q
+  max_age       = "a month"
+}
+`, acctJWT, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Max Age`),
+			},
+		},
+	})
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+var _ datasource.DataSource = &RotationPlanDataSource{}
+
+type RotationPlanDataSource struct{}
+
+type RotationPlanDataSourceModel struct {
+	UserJWTs      types.Map    `tfsdk:"user_jwts"`
+	OldSigningKey types.String `tfsdk:"old_signing_key"`
+	NewSigningKey types.String `tfsdk:"new_signing_key"`
+	Affected      types.List   `tfsdk:"affected"`
+	Unaffected    types.List   `tfsdk:"unaffected"`
+}
+
+func NewRotationPlanDataSource() datasource.DataSource {
+	return &RotationPlanDataSource{}
+}
+
+func (d *RotationPlanDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rotation_plan"
+}
+
+func (d *RotationPlanDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists which user JWTs change issuer when an account key or signing key is rotated.",
+		Attributes: map[string]schema.Attribute{
+			"user_jwts": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Current user JWTs, keyed by user name.",
+			},
+			"old_signing_key": schema.StringAttribute{
+				Required:    true,
+				Description: "Public key (starts with A) being rotated out: the account identity key or one of its signing keys.",
+				Validators:  []validator.String{PublicKeyTypeValidator(nkeys.PrefixByteAccount)},
+			},
+			"new_signing_key": schema.StringAttribute{
+				Required:    true,
+				Description: "Public key (starts with A) that will sign the affected users after the rotation.",
+				Validators:  []validator.String{PublicKeyTypeValidator(nkeys.PrefixByteAccount)},
+			},
+			"affected": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Sorted names of users currently signed by old_signing_key. Their JWTs and creds change issuer and must be reissued.",
+			},
+			"unaffected": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Sorted names of users signed by another key, whose JWTs the rotation leaves unchanged.",
+			},
+		},
+	}
+}
+
+func (d *RotationPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RotationPlanDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oldKey := data.OldSigningKey.ValueString()
+	newKey := data.NewSigningKey.ValueString()
+	if oldKey == newKey {
+		resp.Diagnostics.AddAttributeError(path.Root("new_signing_key"), "Invalid Rotation",
+			"new_signing_key is the same as old_signing_key, so no user would change issuer")
+		return
+	}
+
+	var userJWTs map[string]string
+	resp.Diagnostics.Append(data.UserJWTs.ElementsAs(ctx, &userJWTs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make([]string, 0, len(userJWTs))
+	for name := range userJWTs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	affected := []string{}
+	unaffected := []string{}
+	for _, name := range names {
+		claims, err := natsjwt.DecodeUserClaims(userJWTs[name])
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("user_jwts").AtMapKey(name), "Invalid User JWT",
+				fmt.Sprintf("Failed to decode user JWT for %q: %s", name, err))
+			continue
+		}
+		if claims.Issuer == oldKey {
+			affected = append(affected, name)
+		} else {
+			unaffected = append(unaffected, name)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Affected, diags = types.ListValueFrom(ctx, types.StringType, affected)
+	resp.Diagnostics.Append(diags...)
+	data.Unaffected, diags = types.ListValueFrom(ctx, types.StringType, unaffected)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+// testUserJWT issues a user JWT for a fresh user key, signed by signerSeed on
+// behalf of account.
+func testUserJWT(t *testing.T, name, signerSeed, account string) string {
+	t.Helper()
+	_, userPub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+	signer, err := nkeys.FromSeed([]byte(signerSeed))
+	if err != nil {
+		t.Fatalf("failed to parse signer seed: %v", err)
+	}
+	signerPub, err := signer.PublicKey()
+	if err != nil {
+		t.Fatalf("failed to get signer public key: %v", err)
+	}
+	claims := natsjwt.NewUserClaims(userPub)
+	claims.Name = name
+	if signerPub != account {
+		claims.IssuerAccount = account
+	}
+	jwtString, err := claims.Encode(signer)
+	if err != nil {
+		t.Fatalf("failed to encode user JWT: %v", err)
+	}
+	return jwtString
+}
+
+func TestAccRotationPlanDataSource_Basic(t *testing.T) {
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	oldSKSeed, oldSKPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, newSKPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_rotation_plan" "test" {
+  user_jwts = {
+    bob   = %q
+    alice = %q
+    carol = %q
+  }
+  old_signing_key = %q
+  new_signing_key = %q
+}
+`,
+		testUserJWT(t, "bob", oldSKSeed, acctPub),
+		testUserJWT(t, "alice", oldSKSeed, acctPub),
+		testUserJWT(t, "carol", acctSeed, acctPub),
+		oldSKPub, newSKPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_rotation_plan.test", "affected.#", "2"),
+					resource.TestCheckResourceAttr("data.natsjwt_rotation_plan.test", "affected.0", "alice"),
+					resource.TestCheckResourceAttr("data.natsjwt_rotation_plan.test", "affected.1", "bob"),
+					resource.TestCheckResourceAttr("data.natsjwt_rotation_plan.test", "unaffected.#", "1"),
+					resource.TestCheckResourceAttr("data.natsjwt_rotation_plan.test", "unaffected.0", "carol"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRotationPlanDataSource_Invalid(t *testing.T) {
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, newSKPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, userPub := testSeedAndPublicKey(t, nkeys.PrefixByteUser)
+
+	config := func(userJWT, oldKey, newKey string) string {
+		return fmt.Sprintf(`
+data "natsjwt_rotation_plan" "test" {
+  user_jwts       = { alice = %q }
+  old_signing_key = %q
+  new_signing_key = %q
+}
+`, userJWT, oldKey, newKey)
+	}
+	userJWT := testUserJWT(t, "alice", acctSeed, acctPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(userJWT, acctPub, userPub),
+				ExpectError: regexp.MustCompile(`Wrong NKey Public Key Type`),
+			},
+			{
+				Config:      config(userJWT, "not-a-key", newSKPub),
+				ExpectError: regexp.MustCompile(`Invalid NKey Public Key`),
+			},
+			{
+				Config:      config(userJWT, acctPub, acctPub),
+				ExpectError: regexp.MustCompile(`Invalid Rotation`),
+			},
+			{
+				Config:      config("not-a-jwt", acctPub, newSKPub),
+				ExpectError: regexp.MustCompile(`Invalid User JWT`),
+			},
+		},
+	})
+}
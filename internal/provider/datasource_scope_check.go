@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ datasource.DataSource = &ScopeCheckDataSource{}
+
+type ScopeCheckDataSource struct{}
+
+type ScopeCheckDataSourceModel struct {
+	AccountJWT types.String `tfsdk:"account_jwt"`
+	UserJWT    types.String `tfsdk:"user_jwt"`
+	SigningKey types.String `tfsdk:"signing_key"`
+	Scoped     types.Bool   `tfsdk:"scoped"`
+	Role       types.String `tfsdk:"role"`
+	Conflict   types.Bool   `tfsdk:"conflict"`
+	Conflicts  types.List   `tfsdk:"conflicts"`
+}
+
+func NewScopeCheckDataSource() datasource.DataSource {
+	return &ScopeCheckDataSource{}
+}
+
+func (d *ScopeCheckDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scope_check"
+}
+
+func (d *ScopeCheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks whether a user JWT would be accepted under the scoped signing key of its account that issued it.",
+		Attributes: map[string]schema.Attribute{
+			"account_jwt": schema.StringAttribute{
+				Required:    true,
+				Description: "Account JWT declaring the signing keys.",
+			},
+			"user_jwt": schema.StringAttribute{
+				Required:    true,
+				Description: "User JWT issued by the account or one of its signing keys.",
+			},
+			"signing_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key that signed the user JWT.",
+			},
+			"scoped": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True when signing_key is a scoped signing key of the account.",
+			},
+			"role": schema.StringAttribute{
+				Computed:    true,
+				Description: "Role of the scoped signing key. Null when the user is not scoped or the scope has no role.",
+			},
+			"conflict": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True when the server would reject the user under the account's signing keys.",
+			},
+			"conflicts": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Reasons the user conflicts with its signing key. Empty when conflict is false.",
+			},
+		},
+	}
+}
+
+func (d *ScopeCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScopeCheckDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acctClaims, err := natsjwt.DecodeAccountClaims(data.AccountJWT.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("account_jwt"), "Invalid Account JWT", fmt.Sprintf("Failed to decode account JWT: %s", err))
+		return
+	}
+	userClaims, err := natsjwt.DecodeUserClaims(data.UserJWT.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("user_jwt"), "Invalid User JWT", fmt.Sprintf("Failed to decode user JWT: %s", err))
+		return
+	}
+
+	account := userClaims.Issuer
+	if userClaims.IssuerAccount != "" {
+		account = userClaims.IssuerAccount
+	}
+	if account != acctClaims.Subject {
+		resp.Diagnostics.AddAttributeError(path.Root("user_jwt"), "Account Mismatch",
+			fmt.Sprintf("user_jwt belongs to account %s, not to account %s", account, acctClaims.Subject))
+		return
+	}
+
+	signer := userClaims.Issuer
+	conflicts := []string{}
+	data.Scoped = types.BoolValue(false)
+	data.Role = types.StringNull()
+
+	scope, known := acctClaims.SigningKeys.GetScope(signer)
+	switch {
+	case signer == acctClaims.Subject:
+	case !known:
+		conflicts = append(conflicts, fmt.Sprintf("user is signed by %s, which is not a signing key of the account", signer))
+	case scope != nil:
+		data.Scoped = types.BoolValue(true)
+		if us, ok := scope.(*natsjwt.UserScope); ok {
+			if us.Role != "" {
+				data.Role = types.StringValue(us.Role)
+			}
+			conflicts = append(conflicts, scopedUserConflicts(userClaims, us)...)
+		}
+		if err := scope.ValidateScopedSigner(userClaims); err != nil && len(conflicts) == 0 {
+			conflicts = append(conflicts, err.Error())
+		}
+	}
+
+	conflictsTF, diags := types.ListValueFrom(ctx, types.StringType, conflicts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SigningKey = types.StringValue(signer)
+	data.Conflict = types.BoolValue(len(conflicts) > 0)
+	data.Conflicts = conflictsTF
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// scopedUserConflicts lists the permissions and limits a user sets although
+// its scoped signing key requires it to set none.
+func scopedUserConflicts(uc *natsjwt.UserClaims, scope *natsjwt.UserScope) []string {
+	var conflicts []string
+	upl := uc.UserPermissionLimits
+
+	if !reflect.DeepEqual(upl.Permissions, natsjwt.Permissions{}) {
+		conflicts = append(conflicts, "user sets permissions; the scope template governs permissions")
+	}
+	if !reflect.DeepEqual(upl.NatsLimits, natsjwt.NatsLimits{}) {
+		conflicts = append(conflicts, "user JWT carries subs, data or payload limits, even if unlimited; issue it with use_scoped_permissions = true")
+	}
+	if len(upl.AllowedConnectionTypes) > 0 {
+		allowed := "any connection type"
+		if len(scope.Template.AllowedConnectionTypes) > 0 {
+			allowed = strings.Join(scope.Template.AllowedConnectionTypes, ", ")
+		}
+		conflicts = append(conflicts, fmt.Sprintf("user sets allowed_connection_types %s; the scope template allows %s",
+			strings.Join(upl.AllowedConnectionTypes, ", "), allowed))
+	}
+	if len(upl.Src) > 0 {
+		conflicts = append(conflicts, "user sets source_networks; the scope template governs them")
+	}
+	if len(upl.Times) > 0 || upl.Locale != "" {
+		conflicts = append(conflicts, "user sets time_restrictions or locale; the scope template governs them")
+	}
+	if upl.BearerToken {
+		conflicts = append(conflicts, "user sets bearer_token; the scope template governs it")
+	}
+	return conflicts
+}
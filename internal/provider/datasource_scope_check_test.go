@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccScopeCheckDataSource_ConnectionTypeConflict(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	acctPub, err := publicKeyFromSeed(acctSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scopedSeed, scopedPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "acct" {
+  name          = "scoped-acct"
+  seed          = %[1]q
+  operator_seed = %[2]q
+  scoped_signing_keys = [{
+    key  = %[3]q
+    role = "service"
+    template = {
+      pub_allow                = ["svc.>"]
+      allowed_connection_types = ["STANDARD"]
+    }
+  }]
+}
+
+data "natsjwt_user" "websocket" {
+  name                     = "ws-user"
+  seed                     = %[4]q
+  account_seed             = %[5]q
+  issuer_account           = %[6]q
+  allowed_connection_types = ["WEBSOCKET"]
+}
+
+data "natsjwt_user" "scoped" {
+  name                   = "scoped-user"
+  seed                   = %[4]q
+  account_seed           = %[5]q
+  issuer_account         = %[6]q
+  use_scoped_permissions = true
+}
+
+data "natsjwt_scope_check" "websocket" {
+  account_jwt = data.natsjwt_account.acct.jwt
+  user_jwt    = data.natsjwt_user.websocket.jwt
+}
+
+data "natsjwt_scope_check" "scoped" {
+  account_jwt = data.natsjwt_account.acct.jwt
+  user_jwt    = data.natsjwt_user.scoped.jwt
+}
+`, acctSeed, testOperatorSeed(t), scopedPub, userSeed, scopedSeed, acctPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_scope_check.websocket", "signing_key", scopedPub),
+					resource.TestCheckResourceAttr("data.natsjwt_scope_check.websocket", "scoped", "true"),
+					resource.TestCheckResourceAttr("data.natsjwt_scope_check.websocket", "role", "service"),
+					resource.TestCheckResourceAttr("data.natsjwt_scope_check.websocket", "conflict", "true"),
+					resource.TestCheckTypeSetElemAttr("data.natsjwt_scope_check.websocket", "conflicts.*",
+						"user sets allowed_connection_types WEBSOCKET; the scope template allows STANDARD"),
+					resource.TestCheckResourceAttr("data.natsjwt_scope_check.scoped", "scoped", "true"),
+					resource.TestCheckResourceAttr("data.natsjwt_scope_check.scoped", "conflict", "false"),
+					resource.TestCheckResourceAttr("data.natsjwt_scope_check.scoped", "conflicts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScopeCheckDataSource_UnscopedUser(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "acct" {
+  name          = "plain-acct"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_user" "test" {
+  name                     = "user"
+  seed                     = %q
+  account_seed             = %q
+  allowed_connection_types = ["WEBSOCKET"]
+}
+
+data "natsjwt_scope_check" "test" {
+  account_jwt = data.natsjwt_account.acct.jwt
+  user_jwt    = data.natsjwt_user.test.jwt
+}
+`, acctSeed, opSeed, testUserSeed(t), acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_scope_check.test", "scoped", "false"),
+					resource.TestCheckNoResourceAttr("data.natsjwt_scope_check.test", "role"),
+					resource.TestCheckResourceAttr("data.natsjwt_scope_check.test", "conflict", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScopeCheckDataSource_AccountMismatch(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "acct" {
+  name          = "acct"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_user" "test" {
+  name         = "user"
+  seed         = %q
+  account_seed = %q
+}
+
+data "natsjwt_scope_check" "test" {
+  account_jwt = data.natsjwt_account.acct.jwt
+  user_jwt    = data.natsjwt_user.test.jwt
+}
+`, testAccountSeed(t), opSeed, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Account Mismatch`),
+			},
+		},
+	})
+}
@@ -5,13 +5,42 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	natsjwt "github.com/nats-io/jwt/v2"
 )
 
 var _ datasource.DataSource = &SystemAccountDataSource{}
+var _ datasource.DataSourceWithConfigure = &SystemAccountDataSource{}
 
-type SystemAccountDataSource struct{}
+type SystemAccountDataSource struct {
+	jwtSettings
+}
+
+// SystemAccountDataSourceModel is the account model plus the attributes only the
+// system account has.
+type SystemAccountDataSourceModel struct {
+	AccountDataSourceModel
+	DefaultExportsInfo types.Object `tfsdk:"default_exports_info"`
+}
+
+type ExportInfoModel struct {
+	Description types.String `tfsdk:"description"`
+	InfoURL     types.String `tfsdk:"info_url"`
+}
+
+type DefaultExportsInfoModel struct {
+	AccountMonitoringServices types.Object `tfsdk:"account_monitoring_services"`
+	AccountMonitoringStreams  types.Object `tfsdk:"account_monitoring_streams"`
+}
+
+// defaultExportInfo overrides the Info of the exports added by
+// applySystemAccountDefaults. Empty fields keep the built-in text.
+type defaultExportInfo struct {
+	Services natsjwt.Info
+	Streams  natsjwt.Info
+}
 
 func NewSystemAccountDataSource() datasource.DataSource {
 	return &SystemAccountDataSource{}
@@ -22,23 +51,54 @@ func (d *SystemAccountDataSource) Metadata(_ context.Context, req datasource.Met
 }
 
 func (d *SystemAccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = accountSchema("Generates a signed NATS system account JWT with system-appropriate defaults (includes $SYS.> public service export).")
+	s := accountSchema("Generates a signed NATS system account JWT with system-appropriate defaults (includes $SYS.> public service export).")
+	infoAttribute := func(export string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Info for the %s export.", export),
+			Attributes: map[string]schema.Attribute{
+				"description": schema.StringAttribute{
+					Optional:    true,
+					Description: "Export description. Defaults to the nsc text.",
+				},
+				"info_url": schema.StringAttribute{
+					Optional:    true,
+					Description: "Export info URL. Defaults to the NATS system account documentation.",
+				},
+			},
+		}
+	}
+	s.Attributes["default_exports_info"] = schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Overrides the description and info URL of the default monitoring exports. Has no effect when the default exports are not added because exports already include $SYS.>.",
+		Attributes: map[string]schema.Attribute{
+			"account_monitoring_services": infoAttribute("account-monitoring-services"),
+			"account_monitoring_streams":  infoAttribute("account-monitoring-streams"),
+		},
+	}
+	resp.Schema = s
 }
 
 func (d *SystemAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data AccountDataSourceModel
+	var data SystemAccountDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	claims, pub, err := buildAccountClaims(ctx, data, resp)
+	claims, pub, err := buildAccountClaims(ctx, &data.AccountDataSourceModel, d.issuedAtRoundTo, resp)
 	if err != nil || resp.Diagnostics.HasError() {
 		return
 	}
 
+	info, diags := defaultExportInfoFromModel(ctx, data.DefaultExportsInfo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Apply system account defaults: add $SYS.> public service export if no exports are defined
-	applySystemAccountDefaults(claims)
+	applySystemAccountDefaults(claims, info)
 
 	operatorKP, err := keypairFromSeed(data.OperatorSeed.ValueString())
 	if err != nil {
@@ -46,18 +106,50 @@ func (d *SystemAccountDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	jwtString, err := encodeDeterministic(claims, operatorKP)
+	checkOperatorSigner(ctx, &data.AccountDataSourceModel, operatorKP, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extra, err := parseExtraClaims(data.ExtraClaims.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Extra Claims", fmt.Sprintf("extra_claims %s", err))
+		return
+	}
+
+	jwtString, err := encodeDeterministicWithOptions(claims, operatorKP, extra, omitEmptyFields(data.OmitEmptyFields))
 	if err != nil {
 		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode system account JWT: %s", err))
 		return
 	}
 
+	d.checkJWTSize("system account", jwtString, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	effectiveLimits, diags := effectiveLimitsValue(claims.Limits)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chain, diags := accountChainValue(claims, data.OperatorJWT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.PublicKey = types.StringValue(pub)
 	data.JWT = types.StringValue(jwtString)
+	data.Signature = types.StringValue(jwtSignature(jwtString))
+	data.EffectiveLimits = effectiveLimits
+	data.Chain = chain
+	data.SignedBySigningKey = accountSignedBySigningKey(claims, chain)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func applySystemAccountDefaults(claims *natsjwt.AccountClaims) {
+func applySystemAccountDefaults(claims *natsjwt.AccountClaims, info defaultExportInfo) {
 	// Add the $SYS.> public service export, matching nsc behavior
 	hasSysExport := false
 	for _, exp := range claims.Exports {
@@ -73,20 +165,59 @@ func applySystemAccountDefaults(claims *natsjwt.AccountClaims) {
 			Type:    natsjwt.Service,
 			ResponseType: natsjwt.ResponseTypeSingleton,
 			AccountTokenPosition: 4,
-			Info: natsjwt.Info{
+			Info: mergeExportInfo(natsjwt.Info{
 				Description: "Request account specific monitoring services for: SUBSZ, CONNZ, LEAFZ, JSZ and INFO",
 				InfoURL:     "https://docs.nats.io/nats-server/configuration/sys_accounts",
-			},
+			}, info.Services),
 		})
 		claims.Exports = append(claims.Exports, &natsjwt.Export{
 			Name:    "account-monitoring-streams",
 			Subject: "$SYS.ACCOUNT.*.>",
 			Type:    natsjwt.Stream,
 			AccountTokenPosition: 3,
-			Info: natsjwt.Info{
+			Info: mergeExportInfo(natsjwt.Info{
 				Description: "Account specific monitoring stream",
 				InfoURL:     "https://docs.nats.io/nats-server/configuration/sys_accounts",
-			},
+			}, info.Streams),
 		})
 	}
 }
+
+// mergeExportInfo returns def with the non-empty fields of override applied.
+func mergeExportInfo(def, override natsjwt.Info) natsjwt.Info {
+	if override.Description != "" {
+		def.Description = override.Description
+	}
+	if override.InfoURL != "" {
+		def.InfoURL = override.InfoURL
+	}
+	return def
+}
+
+// defaultExportInfoFromModel reads the default_exports_info attribute.
+func defaultExportInfoFromModel(ctx context.Context, obj types.Object) (defaultExportInfo, diag.Diagnostics) {
+	var info defaultExportInfo
+	var diags diag.Diagnostics
+	if obj.IsNull() || obj.IsUnknown() {
+		return info, diags
+	}
+
+	var model DefaultExportsInfoModel
+	diags.Append(obj.As(ctx, &model, objectAsOptions)...)
+	for _, e := range []struct {
+		value  types.Object
+		target *natsjwt.Info
+	}{
+		{model.AccountMonitoringServices, &info.Services},
+		{model.AccountMonitoringStreams, &info.Streams},
+	} {
+		if e.value.IsNull() || e.value.IsUnknown() {
+			continue
+		}
+		var m ExportInfoModel
+		diags.Append(e.value.As(ctx, &m, objectAsOptions)...)
+		e.target.Description = m.Description.ValueString()
+		e.target.InfoURL = m.InfoURL.ValueString()
+	}
+	return info, diags
+}
@@ -146,3 +146,51 @@ data "natsjwt_system_account" "test" {
 		},
 	})
 }
+
+func TestAccSystemAccountDataSource_DefaultExportsInfo(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_system_account" "test" {
+  name          = "SYS"
+  seed          = %q
+  operator_seed = %q
+  default_exports_info = {
+    account_monitoring_services = {
+      description = "Acme monitoring endpoints"
+    }
+  }
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckJWTField("data.natsjwt_system_account.test", func(jwtStr string) error {
+					claims, err := natsjwt.DecodeAccountClaims(jwtStr)
+					if err != nil {
+						return fmt.Errorf("failed to decode system account JWT: %w", err)
+					}
+					infos := map[string]natsjwt.Info{}
+					for _, exp := range claims.Exports {
+						infos[exp.Name] = exp.Info
+					}
+					services := infos["account-monitoring-services"]
+					if services.Description != "Acme monitoring endpoints" {
+						return fmt.Errorf("expected overridden services description, got %q", services.Description)
+					}
+					if services.InfoURL != "https://docs.nats.io/nats-server/configuration/sys_accounts" {
+						return fmt.Errorf("expected default services info URL, got %q", services.InfoURL)
+					}
+					if streams := infos["account-monitoring-streams"]; streams.Description != "Account specific monitoring stream" {
+						return fmt.Errorf("expected default streams description, got %q", streams.Description)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
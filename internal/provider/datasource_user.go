@@ -2,11 +2,16 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	natsjwt "github.com/nats-io/jwt/v2"
@@ -14,8 +19,11 @@ import (
 )
 
 var _ datasource.DataSource = &UserDataSource{}
+var _ datasource.DataSourceWithConfigure = &UserDataSource{}
 
-type UserDataSource struct{}
+type UserDataSource struct {
+	jwtSettings
+}
 
 type UserPermissionsModel struct {
 	PubAllow    types.List   `tfsdk:"pub_allow"`
@@ -24,6 +32,7 @@ type UserPermissionsModel struct {
 	SubDeny     types.List   `tfsdk:"sub_deny"`
 	RespMaxMsgs types.Int64  `tfsdk:"resp_max_msgs"`
 	RespTTL     types.String `tfsdk:"resp_ttl"`
+	RespType    types.String `tfsdk:"resp_type"`
 }
 
 type UserLimitsModel struct {
@@ -38,24 +47,40 @@ type TimeRangeModel struct {
 }
 
 type UserDataSourceModel struct {
-	Name                   types.String `tfsdk:"name"`
-	Seed                   types.String `tfsdk:"seed"`
-	AccountSeed            types.String `tfsdk:"account_seed"`
-	IssuerAccount          types.String `tfsdk:"issuer_account"`
-	IssuedAt               types.Int64  `tfsdk:"issued_at"`
-	Expires                types.Int64  `tfsdk:"expires"`
-	NotBefore              types.Int64  `tfsdk:"not_before"`
-	Permissions            types.Object `tfsdk:"permissions"`
-	Limits                 types.Object `tfsdk:"limits"`
-	BearerToken            types.Bool   `tfsdk:"bearer_token"`
-	AllowedConnectionTypes types.List   `tfsdk:"allowed_connection_types"`
-	SourceNetworks         types.List   `tfsdk:"source_networks"`
-	TimeRestrictions       types.List   `tfsdk:"time_restrictions"`
-	Locale                 types.String `tfsdk:"locale"`
-	Tags                   types.List   `tfsdk:"tags"`
-	PublicKey              types.String `tfsdk:"public_key"`
-	JWT                    types.String `tfsdk:"jwt"`
-	Creds                  types.String `tfsdk:"creds"`
+	Name                    types.String `tfsdk:"name"`
+	Seed                    types.String `tfsdk:"seed"`
+	AccountSeed             types.String `tfsdk:"account_seed"`
+	IssuerAccount           types.String `tfsdk:"issuer_account"`
+	IssuedAt                types.Int64  `tfsdk:"issued_at"`
+	IssuedAtRFC3339         types.String `tfsdk:"issued_at_rfc3339"`
+	Expires                 types.Int64  `tfsdk:"expires"`
+	MinValidity             types.String `tfsdk:"min_validity"`
+	ReferenceUnix           types.Int64  `tfsdk:"reference_unix"`
+	NotBefore               types.Int64  `tfsdk:"not_before"`
+	Permissions             types.Object `tfsdk:"permissions"`
+	SubjectNamespace        types.String `tfsdk:"subject_namespace"`
+	UseScopedPermissions    types.Bool   `tfsdk:"use_scoped_permissions"`
+	Limits                  types.Object `tfsdk:"limits"`
+	BearerToken             types.Bool   `tfsdk:"bearer_token"`
+	AllowedConnectionTypes  types.List   `tfsdk:"allowed_connection_types"`
+	AllowAllConnectionTypes types.Bool   `tfsdk:"allow_all_connection_types"`
+	SourceNetworks          types.List   `tfsdk:"source_networks"`
+	TimeRestrictions        types.List   `tfsdk:"time_restrictions"`
+	Locale                  types.String `tfsdk:"locale"`
+	Tags                    types.List   `tfsdk:"tags"`
+	ExtraClaims             types.String `tfsdk:"extra_claims"`
+	OmitEmptyFields         types.Bool   `tfsdk:"omit_empty_fields"`
+	AccountJWT              types.String `tfsdk:"account_jwt"`
+	IncludeAccountJWT       types.Bool   `tfsdk:"include_account_jwt"`
+	Effective               types.Object `tfsdk:"effective"`
+	EffectiveDataLimit      types.Int64  `tfsdk:"effective_data_limit"`
+	EffectivePayloadLimit   types.Int64  `tfsdk:"effective_payload_limit"`
+	PublicKey               types.String `tfsdk:"public_key"`
+	JWT                     types.String `tfsdk:"jwt"`
+	Signature               types.String `tfsdk:"signature"`
+	SignedBySigningKey      types.Bool   `tfsdk:"signed_by_signing_key"`
+	Creds                   types.String `tfsdk:"creds"`
+	CredsSHA256             types.String `tfsdk:"creds_sha256"`
 }
 
 func NewUserDataSource() datasource.DataSource {
@@ -72,7 +97,8 @@ func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "User name.",
+				Description: "User name. Must be non-empty, without leading or trailing whitespace, and at most 256 characters.",
+				Validators:  []schemavalidator.String{NameValidator()},
 			},
 			"seed": schema.StringAttribute{
 				Required:    true,
@@ -94,66 +120,37 @@ func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Optional:    true,
 				Description: "JWT issued-at timestamp as Unix seconds. Defaults to 0 (Unix epoch).",
 			},
+			"issued_at_rfc3339": schema.StringAttribute{
+				Optional:    true,
+				Description: "JWT issued-at timestamp in RFC 3339 format, e.g. a commit time such as 2023-01-01T00:00:00Z. Alternative to issued_at; setting both is an error.",
+			},
 			"expires": schema.Int64Attribute{
 				Optional:    true,
 				Description: "JWT expiration timestamp as Unix seconds. Defaults to no expiration.",
 			},
+			"min_validity": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum time the JWT must remain valid after reference_unix, as a Go duration such as 1h. Reading fails when expires is sooner. Ignored when expires is not set.",
+			},
+			"reference_unix": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Unix timestamp min_validity is measured from. Defaults to the current time.",
+			},
 			"not_before": schema.Int64Attribute{
 				Optional:    true,
 				Description: "JWT not-before timestamp as Unix seconds. Defaults to issued_at.",
 			},
-			"permissions": schema.SingleNestedAttribute{
+			"subject_namespace": schema.StringAttribute{
 				Optional:    true,
-				Description: "User permissions for publish and subscribe.",
-				Attributes: map[string]schema.Attribute{
-					"pub_allow": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-						Description: "Subjects allowed for publishing.",
-					},
-					"pub_deny": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-						Description: "Subjects denied for publishing.",
-					},
-					"sub_allow": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-						Description: "Subjects allowed for subscribing.",
-					},
-					"sub_deny": schema.ListAttribute{
-						ElementType: types.StringType,
-						Optional:    true,
-						Description: "Subjects denied for subscribing.",
-					},
-					"resp_max_msgs": schema.Int64Attribute{
-						Optional:    true,
-						Description: "Maximum number of response messages.",
-					},
-					"resp_ttl": schema.StringAttribute{
-						Optional:    true,
-						Description: "Response permission TTL (Go duration string, e.g., '1m', '5s').",
-					},
-				},
+				Description: "Subject prefix without wildcards, e.g. tenant1. When permissions is not set, the user may only publish and subscribe under <namespace>.>.",
+				Validators:  []schemavalidator.String{SubjectNamespaceValidator()},
 			},
-			"limits": schema.SingleNestedAttribute{
+			"use_scoped_permissions": schema.BoolAttribute{
 				Optional:    true,
-				Description: "Connection limits for the user.",
-				Attributes: map[string]schema.Attribute{
-					"subs": schema.Int64Attribute{
-						Optional:    true,
-						Description: "Maximum subscriptions. -1 for unlimited.",
-					},
-					"data": schema.Int64Attribute{
-						Optional:    true,
-						Description: "Maximum data in bytes. -1 for unlimited.",
-					},
-					"payload": schema.Int64Attribute{
-						Optional:    true,
-						Description: "Maximum payload size in bytes. -1 for unlimited.",
-					},
-				},
+				Description: "Issue the user without permissions or limits of its own, so the scope template of the scoped signing key in account_seed applies. Cannot be combined with permissions, subject_namespace, limits, bearer_token, allowed_connection_types, source_networks, time_restrictions or locale. Default false.",
 			},
+			"permissions": userPermissionsAttribute(),
+			"limits":      userLimitsAttribute(),
 			"bearer_token": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Allow bearer token authentication. Default false.",
@@ -163,6 +160,10 @@ func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Optional:    true,
 				Description: "Allowed connection types: STANDARD, WEBSOCKET, LEAFNODE, MQTT.",
 			},
+			"allow_all_connection_types": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True when the user is not restricted to specific connection types. An empty allowed_connection_types list is omitted from the JWT and therefore also allows all types.",
+			},
 			"source_networks": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -170,7 +171,8 @@ func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 			},
 			"time_restrictions": schema.ListNestedAttribute{
 				Optional:    true,
-				Description: "Time-based access restrictions.",
+				Description: "Time-based access restrictions. A range whose end is before its start wraps midnight and raises a warning.",
+				Validators:  []schemavalidator.List{TimeRestrictionsValidator()},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"start": schema.StringAttribute{
@@ -193,6 +195,36 @@ func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Optional:    true,
 				Description: "Tags for the user.",
 			},
+			"extra_claims": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSON object of additional top-level claim fields merged into the JWT payload before signing, for experimental server features. Standard fields (iss, sub, iat, exp, nbf, jti, aud, name, nats) cannot be set.",
+				Validators:  []schemavalidator.String{ExtraClaimsValidator()},
+			},
+			"omit_empty_fields": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Omit zero-valued optional claim fields from the JWT payload, as the nats jwt library does. Default true. Set to false to write explicit zero values for servers that expect every field to be present.",
+			},
+			"account_jwt": schema.StringAttribute{
+				Optional:    true,
+				Description: "JWT of the account the user belongs to. Only used with include_account_jwt.",
+			},
+			"include_account_jwt": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Append account_jwt as a decorated block after the user JWT and seed in creds, for tools that want the account alongside the user. NATS clients read only the first JWT and seed blocks. Default false.",
+			},
+			"effective": schema.ObjectAttribute{
+				AttributeTypes: userEffectiveAttrTypes,
+				Computed:       true,
+				Description:    "Permissions and limits as encoded in the JWT, after defaults are applied. -1 means unlimited; empty lists mean no restriction. Limits are null for scoped users.",
+			},
+			"effective_data_limit": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Aggregate per-connection data limit in bytes as encoded in the JWT, after defaults are applied. -1 means unlimited. Null when use_scoped_permissions is true.",
+			},
+			"effective_payload_limit": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Per-message payload limit in bytes as encoded in the JWT, after defaults are applied. -1 means unlimited. Null when use_scoped_permissions is true.",
+			},
 			"public_key": schema.StringAttribute{
 				Computed:    true,
 				Description: "The user's public key.",
@@ -201,11 +233,92 @@ func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 				Description: "The signed user JWT.",
 			},
+			"signature": schema.StringAttribute{
+				Computed:    true,
+				Description: "Signature segment of the user JWT (the part after the last dot), base64url encoded without padding. It signs the header and payload segments joined by a dot.",
+			},
+			"signed_by_signing_key": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True when account_seed is a signing key of the account named in issuer_account rather than the account identity key.",
+			},
 			"creds": schema.StringAttribute{
 				Computed:    true,
 				Sensitive:   true,
 				Description: "NATS user credentials file content (decorated JWT + decorated seed).",
 			},
+			"creds_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hex-encoded SHA-256 of creds, for detecting drift in written creds files without comparing the secret itself.",
+			},
+		},
+	}
+}
+
+// userPermissionsAttribute is the permissions schema shared by user and account_creds.
+func userPermissionsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "User permissions for publish and subscribe.",
+		Attributes: map[string]schema.Attribute{
+			"pub_allow": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Subjects allowed for publishing.",
+				Validators:  []schemavalidator.List{SubjectListValidator()},
+			},
+			"pub_deny": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Subjects denied for publishing.",
+				Validators:  []schemavalidator.List{SubjectListValidator()},
+			},
+			"sub_allow": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Subjects allowed for subscribing.",
+				Validators:  []schemavalidator.List{SubjectListValidator()},
+			},
+			"sub_deny": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Subjects denied for subscribing.",
+				Validators:  []schemavalidator.List{SubjectListValidator()},
+			},
+			"resp_max_msgs": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of response messages.",
+			},
+			"resp_ttl": schema.StringAttribute{
+				Optional:    true,
+				Description: "Response permission TTL (Go duration string, e.g., '1m', '5s').",
+			},
+			"resp_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Response type: singleton, stream or chunked. User JWTs only carry a response count and TTL, so only singleton (a single response, equivalent to resp_max_msgs = 1) can be expressed; stream and chunked must be configured on the service export instead.",
+				Validators:  []schemavalidator.String{ResponseTypeValidator()},
+			},
+		},
+	}
+}
+
+// userLimitsAttribute is the limits schema shared by user and account_creds.
+func userLimitsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Connection limits for the user.",
+		Attributes: map[string]schema.Attribute{
+			"subs": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum subscriptions. -1 for unlimited.",
+			},
+			"data": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum total bytes a connection of this user may send over its lifetime (aggregate, not per message). -1 for unlimited.",
+			},
+			"payload": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum size in bytes of a single message payload. -1 for unlimited.",
+			},
 		},
 	}
 }
@@ -217,38 +330,114 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	userKP, err := keypairFromSeed(data.Seed.ValueString())
+	claims, userPub, err := buildUserClaims(ctx, &data, d.issuedAtRoundTo, resp)
+	if err != nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountKP, err := keypairFromSeed(data.AccountSeed.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid User Seed", fmt.Sprintf("Failed to parse user seed: %s", err))
+		resp.Diagnostics.AddError("Invalid Account Seed", fmt.Sprintf("Failed to parse account seed: %s", err))
 		return
 	}
 
-	userPub, err := userKP.PublicKey()
+	extra, err := parseExtraClaims(data.ExtraClaims.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Public Key Error", fmt.Sprintf("Failed to get user public key: %s", err))
+		resp.Diagnostics.AddError("Invalid Extra Claims", fmt.Sprintf("extra_claims %s", err))
 		return
 	}
 
-	accountKP, err := keypairFromSeed(data.AccountSeed.ValueString())
+	jwtString, err := encodeDeterministicWithOptions(claims, accountKP, extra, omitEmptyFields(data.OmitEmptyFields))
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid Account Seed", fmt.Sprintf("Failed to parse account seed: %s", err))
+		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode user JWT: %s", err))
 		return
 	}
 
+	d.checkJWTSize("user", jwtString, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	credsBytes, err := natsjwt.FormatUserConfig(jwtString, []byte(data.Seed.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Credentials Encoding Error", fmt.Sprintf("Failed to encode user credentials: %s", err))
+		return
+	}
+
+	if data.IncludeAccountJWT.ValueBool() {
+		credsBytes = appendAccountJWT(credsBytes, data.AccountJWT, userIssuerAccount(claims), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.PublicKey = types.StringValue(userPub)
+	data.AllowAllConnectionTypes = types.BoolValue(len(claims.AllowedConnectionTypes) == 0)
+	// Scoped users carry no limits; the signing key's template decides them.
+	if data.UseScopedPermissions.ValueBool() {
+		data.EffectiveDataLimit = types.Int64Null()
+		data.EffectivePayloadLimit = types.Int64Null()
+	} else {
+		data.EffectiveDataLimit = types.Int64Value(claims.Limits.Data)
+		data.EffectivePayloadLimit = types.Int64Value(claims.Limits.Payload)
+	}
+	data.JWT = types.StringValue(jwtString)
+	data.Signature = types.StringValue(jwtSignature(jwtString))
+	data.SignedBySigningKey = signedBySigningKey(claims.Issuer, userIssuerAccount(claims))
+	data.Creds = types.StringValue(string(credsBytes))
+	effective, diags := userEffectiveValue(ctx, claims, data.UseScopedPermissions.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Effective = effective
+	credsSum := sha256.Sum256(credsBytes)
+	data.CredsSHA256 = types.StringValue(hex.EncodeToString(credsSum[:]))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildUserClaims constructs user claims from the data model. Shared by user, account_creds and leafnode_account.
+func buildUserClaims(ctx context.Context, data *UserDataSourceModel, roundTo time.Duration, resp *datasource.ReadResponse) (*natsjwt.UserClaims, string, error) {
+	userKP, err := keypairFromSeed(data.Seed.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Seed", fmt.Sprintf("Failed to parse user seed: %s", err))
+		return nil, "", err
+	}
+
+	userPub, err := userKP.PublicKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Public Key Error", fmt.Sprintf("Failed to get user public key: %s", err))
+		return nil, "", err
+	}
+
 	claims := natsjwt.NewUserClaims(userPub)
 	claims.Name = data.Name.ValueString()
-	applyTemporalClaimsDefaults(claims.Claims(), data.IssuedAt, data.Expires, data.NotBefore)
+	issuedAt := resolveIssuedAt(data.IssuedAt, data.IssuedAtRFC3339, roundTo, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return nil, "", fmt.Errorf("invalid issued_at")
+	}
+	applyTemporalClaimsDefaults(claims.Claims(), issuedAt, data.Expires, data.NotBefore)
+	checkMinValidity(data.Expires, data.MinValidity, data.ReferenceUnix, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return nil, "", fmt.Errorf("insufficient validity")
+	}
 
 	if !data.IssuerAccount.IsNull() {
 		claims.IssuerAccount = data.IssuerAccount.ValueString()
 	}
 
+	if data.UseScopedPermissions.ValueBool() {
+		checkScopedUserConflicts(*data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return nil, "", fmt.Errorf("conflicting scoped permissions")
+		}
+	}
+
 	// Permissions
 	if !data.Permissions.IsNull() {
 		var perms UserPermissionsModel
 		resp.Diagnostics.Append(data.Permissions.As(ctx, &perms, objectAsOptions)...)
 		if resp.Diagnostics.HasError() {
-			return
+			return nil, "", fmt.Errorf("failed to read permissions")
 		}
 
 		var pubAllow, pubDeny, subAllow, subDeny []string
@@ -265,26 +454,47 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			resp.Diagnostics.Append(perms.SubDeny.ElementsAs(ctx, &subDeny, false)...)
 		}
 		if resp.Diagnostics.HasError() {
-			return
+			return nil, "", fmt.Errorf("failed to read permissions lists")
 		}
 
 		claims.Pub = buildPermission(pubAllow, pubDeny)
 		claims.Sub = buildPermission(subAllow, subDeny)
 
-		if !perms.RespMaxMsgs.IsNull() || !perms.RespTTL.IsNull() {
+		if !perms.RespType.IsNull() {
+			switch respType := perms.RespType.ValueString(); respType {
+			case "singleton":
+				if !perms.RespMaxMsgs.IsNull() && perms.RespMaxMsgs.ValueInt64() != 1 {
+					resp.Diagnostics.AddError("Conflicting Response Permission",
+						fmt.Sprintf("resp_type %q allows exactly one response, but resp_max_msgs is %d", respType, perms.RespMaxMsgs.ValueInt64()))
+					return nil, "", fmt.Errorf("conflicting response permission")
+				}
+			default:
+				resp.Diagnostics.AddError("Unsupported Response Type",
+					fmt.Sprintf("resp_type %q cannot be expressed in a user JWT: response permissions only support a message count (resp_max_msgs) and TTL (resp_ttl). Configure the response type on the service export instead.", respType))
+				return nil, "", fmt.Errorf("unsupported response type")
+			}
+		}
+
+		if !perms.RespMaxMsgs.IsNull() || !perms.RespTTL.IsNull() || !perms.RespType.IsNull() {
 			claims.Resp = &natsjwt.ResponsePermission{}
 			if !perms.RespMaxMsgs.IsNull() {
 				claims.Resp.MaxMsgs = int(perms.RespMaxMsgs.ValueInt64())
+			} else if !perms.RespType.IsNull() {
+				claims.Resp.MaxMsgs = 1
 			}
 			if !perms.RespTTL.IsNull() {
 				ttl, err := time.ParseDuration(perms.RespTTL.ValueString())
 				if err != nil {
 					resp.Diagnostics.AddError("Invalid Duration", fmt.Sprintf("Failed to parse resp_ttl: %s", err))
-					return
+					return nil, "", err
 				}
 				claims.Resp.Expires = ttl
 			}
 		}
+	} else if !data.SubjectNamespace.IsNull() {
+		scope := []string{data.SubjectNamespace.ValueString() + ".>"}
+		claims.Pub = buildPermission(scope, nil)
+		claims.Sub = buildPermission(scope, nil)
 	}
 
 	// Limits
@@ -292,7 +502,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		var limits UserLimitsModel
 		resp.Diagnostics.Append(data.Limits.As(ctx, &limits, objectAsOptions)...)
 		if resp.Diagnostics.HasError() {
-			return
+			return nil, "", fmt.Errorf("failed to read limits")
 		}
 		if !limits.Subs.IsNull() {
 			claims.Subs = limits.Subs.ValueInt64()
@@ -316,14 +526,17 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		claims.BearerToken = data.BearerToken.ValueBool()
 	}
 
-	// Allowed connection types
+	// Allowed connection types, source networks and time restrictions: an empty
+	// list means no restriction, same as null, and leaves the claim nil.
 	if !data.AllowedConnectionTypes.IsNull() {
 		var connTypes []string
 		resp.Diagnostics.Append(data.AllowedConnectionTypes.ElementsAs(ctx, &connTypes, false)...)
 		if resp.Diagnostics.HasError() {
-			return
+			return nil, "", fmt.Errorf("failed to read allowed connection types")
+		}
+		if len(connTypes) > 0 {
+			claims.AllowedConnectionTypes = connTypes
 		}
-		claims.AllowedConnectionTypes = connTypes
 	}
 
 	// Source networks
@@ -331,9 +544,11 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		var networks []string
 		resp.Diagnostics.Append(data.SourceNetworks.ElementsAs(ctx, &networks, false)...)
 		if resp.Diagnostics.HasError() {
-			return
+			return nil, "", fmt.Errorf("failed to read source networks")
+		}
+		if len(networks) > 0 {
+			claims.Src = networks
 		}
-		claims.Src = networks
 	}
 
 	// Time restrictions
@@ -341,7 +556,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		var timeRanges []TimeRangeModel
 		resp.Diagnostics.Append(data.TimeRestrictions.ElementsAs(ctx, &timeRanges, false)...)
 		if resp.Diagnostics.HasError() {
-			return
+			return nil, "", fmt.Errorf("failed to read time restrictions")
 		}
 		for _, tr := range timeRanges {
 			claims.Times = append(claims.Times, natsjwt.TimeRange{
@@ -356,29 +571,130 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		claims.Locale = data.Locale.ValueString()
 	}
 
+	// nats-server rejects scoped users whose JWT carries any permissions or
+	// limits, including the unlimited defaults NewUserClaims sets.
+	if data.UseScopedPermissions.ValueBool() {
+		claims.UserPermissionLimits = natsjwt.UserPermissionLimits{}
+	}
+
 	// Tags
 	if !data.Tags.IsNull() {
 		var tags []string
 		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
 		if resp.Diagnostics.HasError() {
-			return
+			return nil, "", fmt.Errorf("failed to read tags")
 		}
 		claims.Tags = tags
 	}
 
-	jwtString, err := encodeDeterministic(claims, accountKP)
+	return claims, userPub, nil
+}
+
+// appendAccountJWT appends accountJWT to creds as a decorated account JWT block.
+// The account must be the one the user belongs to.
+func appendAccountJWT(creds []byte, accountJWT types.String, userAccount string, diags *diag.Diagnostics) []byte {
+	if accountJWT.IsNull() || accountJWT.ValueString() == "" {
+		diags.AddAttributeError(path.Root("account_jwt"), "Missing Account JWT",
+			"account_jwt must be set when include_account_jwt is true")
+		return nil
+	}
+
+	acctClaims, err := natsjwt.DecodeAccountClaims(accountJWT.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode user JWT: %s", err))
-		return
+		diags.AddAttributeError(path.Root("account_jwt"), "Invalid Account JWT", fmt.Sprintf("Failed to decode account JWT: %s", err))
+		return nil
 	}
-	credsBytes, err := natsjwt.FormatUserConfig(jwtString, []byte(data.Seed.ValueString()))
+	if acctClaims.Subject != userAccount {
+		diags.AddAttributeError(path.Root("account_jwt"), "Account Mismatch",
+			fmt.Sprintf("account_jwt is for account %s, but the user belongs to %s", acctClaims.Subject, userAccount))
+		return nil
+	}
+
+	decorated, err := natsjwt.DecorateJWT(accountJWT.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Credentials Encoding Error", fmt.Sprintf("Failed to encode user credentials: %s", err))
-		return
+		diags.AddError("Credentials Encoding Error", fmt.Sprintf("Failed to decorate account JWT: %s", err))
+		return nil
 	}
+	return append(append(creds, '\n'), decorated...)
+}
 
-	data.PublicKey = types.StringValue(userPub)
-	data.JWT = types.StringValue(jwtString)
-	data.Creds = types.StringValue(string(credsBytes))
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+// userIssuerAccount returns the account a user belongs to: issuer_account when
+// set, otherwise the account that signed it.
+func userIssuerAccount(claims *natsjwt.UserClaims) string {
+	if claims.IssuerAccount != "" {
+		return claims.IssuerAccount
+	}
+	return claims.Issuer
+}
+
+var userEffectiveAttrTypes = map[string]attr.Type{
+	"subs":                     types.Int64Type,
+	"data":                     types.Int64Type,
+	"payload":                  types.Int64Type,
+	"bearer_token":             types.BoolType,
+	"pub_allow":                types.ListType{ElemType: types.StringType},
+	"pub_deny":                 types.ListType{ElemType: types.StringType},
+	"sub_allow":                types.ListType{ElemType: types.StringType},
+	"sub_deny":                 types.ListType{ElemType: types.StringType},
+	"allowed_connection_types": types.ListType{ElemType: types.StringType},
+	"source_networks":          types.ListType{ElemType: types.StringType},
+	"locale":                   types.StringType,
+}
+
+// userEffectiveValue reports the permissions and limits of built user claims.
+// Limits are null for scoped users, whose signing key's template decides them.
+func userEffectiveValue(ctx context.Context, claims *natsjwt.UserClaims, scoped bool) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	list := func(values []string) attr.Value {
+		v, d := types.ListValueFrom(ctx, types.StringType, append([]string{}, values...))
+		diags.Append(d...)
+		return v
+	}
+	values := map[string]attr.Value{
+		"subs":                     types.Int64Value(claims.Limits.Subs),
+		"data":                     types.Int64Value(claims.Limits.Data),
+		"payload":                  types.Int64Value(claims.Limits.Payload),
+		"bearer_token":             types.BoolValue(claims.BearerToken),
+		"pub_allow":                list(claims.Pub.Allow),
+		"pub_deny":                 list(claims.Pub.Deny),
+		"sub_allow":                list(claims.Sub.Allow),
+		"sub_deny":                 list(claims.Sub.Deny),
+		"allowed_connection_types": list(claims.AllowedConnectionTypes),
+		"source_networks":          list(claims.Src),
+		"locale":                   types.StringValue(claims.Locale),
+	}
+	if scoped {
+		values["subs"] = types.Int64Null()
+		values["data"] = types.Int64Null()
+		values["payload"] = types.Int64Null()
+	}
+	if diags.HasError() {
+		return types.ObjectNull(userEffectiveAttrTypes), diags
+	}
+	obj, d := types.ObjectValue(userEffectiveAttrTypes, values)
+	diags.Append(d...)
+	return obj, diags
+}
+
+// checkScopedUserConflicts reports attributes that would give a scoped user
+// permissions or limits of its own.
+func checkScopedUserConflicts(data UserDataSourceModel, diags *diag.Diagnostics) {
+	for _, a := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"permissions", data.Permissions},
+		{"subject_namespace", data.SubjectNamespace},
+		{"limits", data.Limits},
+		{"bearer_token", data.BearerToken},
+		{"allowed_connection_types", data.AllowedConnectionTypes},
+		{"source_networks", data.SourceNetworks},
+		{"time_restrictions", data.TimeRestrictions},
+		{"locale", data.Locale},
+	} {
+		if !a.value.IsNull() {
+			diags.AddAttributeError(path.Root(a.name), "Conflicting Scoped Permissions",
+				fmt.Sprintf("%s cannot be set when use_scoped_permissions is true: the scoped signing key's template governs the user's permissions and limits", a.name))
+		}
+	}
 }
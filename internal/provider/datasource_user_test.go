@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -45,6 +49,7 @@ data "natsjwt_user" "test" {
 					resource.TestCheckResourceAttrSet("data.natsjwt_user.test", "jwt"),
 					resource.TestCheckResourceAttrSet("data.natsjwt_user.test", "creds"),
 					resource.TestMatchResourceAttr("data.natsjwt_user.test", "public_key", regexp.MustCompile(`^U`)),
+					resource.TestCheckResourceAttr("data.natsjwt_user.test", "allow_all_connection_types", "true"),
 					testCheckUserCredsConsistency("data.natsjwt_user.test", userSeed),
 					testCheckJWTField("data.natsjwt_user.test", func(jwtStr string) error {
 						claims, err := natsjwt.DecodeUserClaims(jwtStr)
@@ -117,6 +122,268 @@ data "natsjwt_user" "test" {
 	})
 }
 
+func TestAccUserDataSource_SubjectNamespace(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name              = "tenant-user"
+  seed              = %q
+  account_seed      = %q
+  subject_namespace = "tenant1"
+}
+`, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_user.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeUserClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode user JWT: %w", err)
+						}
+						if len(claims.Pub.Allow) != 1 || claims.Pub.Allow[0] != "tenant1.>" {
+							return fmt.Errorf("expected pub_allow [tenant1.>], got %v", claims.Pub.Allow)
+						}
+						if len(claims.Sub.Allow) != 1 || claims.Sub.Allow[0] != "tenant1.>" {
+							return fmt.Errorf("expected sub_allow [tenant1.>], got %v", claims.Sub.Allow)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_SubjectNamespaceWildcard(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name              = "tenant-user"
+  seed              = %q
+  account_seed      = %q
+  subject_namespace = "tenant1.*"
+}
+`, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Subject Namespace`),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_QueueGroupSubject(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "queue-user"
+  seed         = %q
+  account_seed = %q
+  permissions = {
+    pub_allow = ["foo bar"]
+  }
+}
+`, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Queue Group Not Allowed`),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_UseScopedPermissions(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	acctPub, err := publicKeyFromSeed(acctSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scopedSeed, scopedPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "acct" {
+  name          = "scoped-acct"
+  seed          = %q
+  operator_seed = %q
+  scoped_signing_keys = [{
+    key      = %q
+    template = { pub_allow = ["svc.>"] }
+  }]
+}
+
+data "natsjwt_user" "test" {
+  name                   = "scoped-user"
+  seed                   = %q
+  account_seed           = %q
+  issuer_account         = %q
+  use_scoped_permissions = true
+}
+`, acctSeed, testOperatorSeed(t), scopedPub, testUserSeed(t), scopedSeed, acctPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					resources := s.RootModule().Resources
+					userClaims, err := natsjwt.DecodeUserClaims(resources["data.natsjwt_user.test"].Primary.Attributes["jwt"])
+					if err != nil {
+						return fmt.Errorf("failed to decode user JWT: %w", err)
+					}
+					if len(userClaims.Pub.Allow) != 0 || len(userClaims.Sub.Allow) != 0 || !userClaims.HasEmptyPermissions() {
+						return fmt.Errorf("expected empty permissions, got pub=%v sub=%v", userClaims.Pub, userClaims.Sub)
+					}
+					acctClaims, err := natsjwt.DecodeAccountClaims(resources["data.natsjwt_account.acct"].Primary.Attributes["jwt"])
+					if err != nil {
+						return fmt.Errorf("failed to decode account JWT: %w", err)
+					}
+					scope, ok := acctClaims.SigningKeys.GetScope(scopedPub)
+					if !ok || scope == nil {
+						return fmt.Errorf("scoped signing key %s missing from account", scopedPub)
+					}
+					if err := scope.ValidateScopedSigner(userClaims); err != nil {
+						return fmt.Errorf("scope rejected user: %w", err)
+					}
+					if _, ok := resources["data.natsjwt_user.test"].Primary.Attributes["effective_data_limit"]; ok {
+						return fmt.Errorf("expected effective_data_limit to be null for a scoped user")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_UseScopedPermissionsConflict(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name                   = "scoped-user"
+  seed                   = %q
+  account_seed           = %q
+  use_scoped_permissions = true
+  permissions = {
+    pub_allow = ["foo.>"]
+  }
+}
+`, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Conflicting Scoped Permissions`),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_ResponseTypeSingleton(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "resp-user"
+  seed         = %q
+  account_seed = %q
+  permissions = {
+    resp_type = "singleton"
+    resp_ttl  = "5s"
+  }
+}
+`, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckJWTField("data.natsjwt_user.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeUserClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode user JWT: %w", err)
+						}
+						if claims.Resp == nil {
+							return fmt.Errorf("expected response permission to be set")
+						}
+						if claims.Resp.MaxMsgs != 1 {
+							return fmt.Errorf("expected resp max msgs 1, got %d", claims.Resp.MaxMsgs)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_ResponseTypeStreamUnsupported(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "resp-user"
+  seed         = %q
+  account_seed = %q
+  permissions = {
+    resp_type = "stream"
+  }
+}
+`, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Unsupported Response Type`),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_ResponseTypeInvalid(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "resp-user"
+  seed         = %q
+  account_seed = %q
+  permissions = {
+    resp_type = "broadcast"
+  }
+}
+`, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Response Type`),
+			},
+		},
+	})
+}
+
 func TestAccUserDataSource_ConnectionTypes(t *testing.T) {
 	acctSeed := testAccountSeed(t)
 	userSeed := testUserSeed(t)
@@ -136,6 +403,7 @@ data "natsjwt_user" "test" {
 			{
 				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_user.test", "allow_all_connection_types", "false"),
 					testCheckJWTField("data.natsjwt_user.test", func(jwtStr string) error {
 						claims, err := natsjwt.DecodeUserClaims(jwtStr)
 						if err != nil {
@@ -306,3 +574,479 @@ func testCheckUserCredsConsistency(resourceName, expectedSeed string) resource.T
 		return nil
 	}
 }
+
+func TestAccUserDataSource_EmptyRestrictionLists(t *testing.T) {
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_user" "omitted" {
+  name         = "restricted-user"
+  seed         = %[1]q
+  account_seed = %[2]q
+}
+
+data "natsjwt_user" "empty" {
+  name                     = "restricted-user"
+  seed                     = %[1]q
+  account_seed             = %[2]q
+  source_networks          = []
+  time_restrictions        = []
+  allowed_connection_types = []
+}
+`, userSeed, acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.natsjwt_user.empty", "jwt", "data.natsjwt_user.omitted", "jwt"),
+					testCheckJWTField("data.natsjwt_user.empty", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeUserClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode user JWT: %w", err)
+						}
+						if claims.Src != nil {
+							return fmt.Errorf("expected nil source networks, got %v", claims.Src)
+						}
+						if claims.Times != nil {
+							return fmt.Errorf("expected nil time restrictions, got %v", claims.Times)
+						}
+						if claims.AllowedConnectionTypes != nil {
+							return fmt.Errorf("expected nil connection types, got %v", claims.AllowedConnectionTypes)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_ExplicitZeroLimits(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "zero-limits"
+  seed         = %q
+  account_seed = %q
+  limits = {
+    payload = 0
+  }
+}
+`, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckJWTField("data.natsjwt_user.test", func(jwtStr string) error {
+					claims, err := natsjwt.DecodeUserClaims(jwtStr)
+					if err != nil {
+						return fmt.Errorf("failed to decode user JWT: %w", err)
+					}
+					if claims.Limits.Payload != 0 {
+						return fmt.Errorf("expected payload 0, got %d", claims.Limits.Payload)
+					}
+					if claims.Limits.Subs != -1 || claims.Limits.Data != -1 {
+						return fmt.Errorf("expected unset limits to be -1, got subs=%d data=%d", claims.Limits.Subs, claims.Limits.Data)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_EffectiveLimits(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_user" "defaults" {
+  name         = "defaults"
+  seed         = %[1]q
+  account_seed = %[2]q
+}
+
+data "natsjwt_user" "limited" {
+  name         = "limited"
+  seed         = %[1]q
+  account_seed = %[2]q
+  limits = {
+    data    = 1048576
+    payload = 4096
+  }
+}
+
+data "natsjwt_user" "payload_only" {
+  name         = "payload-only"
+  seed         = %[1]q
+  account_seed = %[2]q
+  limits = {
+    payload = 512
+  }
+}
+`, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_user.defaults", "effective_data_limit", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.defaults", "effective_payload_limit", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.limited", "effective_data_limit", "1048576"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.limited", "effective_payload_limit", "4096"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.payload_only", "effective_data_limit", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.payload_only", "effective_payload_limit", "512"),
+					testCheckJWTField("data.natsjwt_user.limited", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeUserClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode user JWT: %w", err)
+						}
+						if claims.Limits.Data != 1048576 {
+							return fmt.Errorf("expected aggregate data limit 1048576, got %d", claims.Limits.Data)
+						}
+						if claims.Limits.Payload != 4096 {
+							return fmt.Errorf("expected payload limit 4096, got %d", claims.Limits.Payload)
+						}
+						if claims.Limits.Subs != -1 {
+							return fmt.Errorf("expected unset subs to be -1, got %d", claims.Limits.Subs)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_CredsSHA256(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "hashed"
+  seed         = %q
+  account_seed = %q
+}
+`, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					attrs := s.RootModule().Resources["data.natsjwt_user.test"].Primary.Attributes
+					sum := sha256.Sum256([]byte(attrs["creds"]))
+					if want := hex.EncodeToString(sum[:]); attrs["creds_sha256"] != want {
+						return fmt.Errorf("expected creds_sha256 %s, got %s", want, attrs["creds_sha256"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_Effective(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_user" "defaults" {
+  name         = "defaults"
+  seed         = %[1]q
+  account_seed = %[2]q
+}
+
+data "natsjwt_user" "restricted" {
+  name                     = "restricted"
+  seed                     = %[1]q
+  account_seed             = %[2]q
+  bearer_token             = true
+  allowed_connection_types = ["WEBSOCKET"]
+  permissions = {
+    pub_allow = ["app.>"]
+    sub_deny  = ["admin.>"]
+  }
+  limits = {
+    payload = 1024
+  }
+}
+
+data "natsjwt_user" "scoped" {
+  name                   = "scoped"
+  seed                   = %[1]q
+  account_seed           = %[2]q
+  use_scoped_permissions = true
+}
+`, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_user.defaults", "effective.subs", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.defaults", "effective.data", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.defaults", "effective.payload", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.defaults", "effective.bearer_token", "false"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.defaults", "effective.pub_allow.#", "0"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.restricted", "effective.subs", "-1"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.restricted", "effective.payload", "1024"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.restricted", "effective.bearer_token", "true"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.restricted", "effective.pub_allow.0", "app.>"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.restricted", "effective.sub_deny.0", "admin.>"),
+					resource.TestCheckResourceAttr("data.natsjwt_user.restricted", "effective.allowed_connection_types.0", "WEBSOCKET"),
+					// Scoped users report no limits of their own, like effective_data_limit
+					resource.TestCheckNoResourceAttr("data.natsjwt_user.scoped", "effective.subs"),
+					resource.TestCheckNoResourceAttr("data.natsjwt_user.scoped", "effective.data"),
+					resource.TestCheckNoResourceAttr("data.natsjwt_user.scoped", "effective.payload"),
+					resource.TestCheckNoResourceAttr("data.natsjwt_user.scoped", "effective_data_limit"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_IssuedAtRoundTo(t *testing.T) {
+	user := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "rounded"
+  seed         = %q
+  account_seed = %q
+  issued_at    = 3661
+}
+`, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "natsjwt" {
+  issued_at_round_to = "1500ms"
+}
+` + user,
+				ExpectError: regexp.MustCompile(`Invalid Issued At Rounding`),
+			},
+			{
+				Config: `
+provider "natsjwt" {
+  issued_at_round_to = "1h"
+}
+` + user,
+				Check: testCheckJWTField("data.natsjwt_user.test", func(jwtStr string) error {
+					claims, err := natsjwt.DecodeUserClaims(jwtStr)
+					if err != nil {
+						return fmt.Errorf("failed to decode user JWT: %w", err)
+					}
+					if claims.IssuedAt != 3600 {
+						return fmt.Errorf("expected IssuedAt 3600, got %d", claims.IssuedAt)
+					}
+					if claims.NotBefore != 3600 {
+						return fmt.Errorf("expected NotBefore to default to the rounded IssuedAt, got %d", claims.NotBefore)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_SignedBySigningKey(t *testing.T) {
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	signingSeed, signingPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	userSeed := testUserSeed(t)
+
+	config := func(signer string) string {
+		return fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name           = "signed-user"
+  seed           = %q
+  account_seed   = %q
+  issuer_account = %q
+}
+`, userSeed, signer, acctPub)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "signed-user"
+  seed         = %q
+  account_seed = %q
+}
+`, userSeed, acctSeed),
+				Check: resource.TestCheckResourceAttr("data.natsjwt_user.test", "signed_by_signing_key", "false"),
+			},
+			{
+				Config: config(acctSeed),
+				Check:  resource.TestCheckResourceAttr("data.natsjwt_user.test", "signed_by_signing_key", "false"),
+			},
+			{
+				Config: config(signingSeed),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.natsjwt_user.test", "signed_by_signing_key", "true"),
+					testCheckJWTField("data.natsjwt_user.test", func(jwtStr string) error {
+						claims, err := natsjwt.DecodeUserClaims(jwtStr)
+						if err != nil {
+							return fmt.Errorf("failed to decode user JWT: %w", err)
+						}
+						if claims.Issuer != signingPub {
+							return fmt.Errorf("expected issuer %s, got %s", signingPub, claims.Issuer)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_IncludeAccountJWT(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	userSeed := testUserSeed(t)
+
+	config := func(accountJWT string) string {
+		return fmt.Sprintf(`
+data "natsjwt_account" "acct" {
+  name          = "bundled"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_account" "other" {
+  name          = "other"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_user" "test" {
+  name                = "bundled-user"
+  seed                = %q
+  account_seed        = %q
+  include_account_jwt = true
+  account_jwt         = %s
+}
+`, acctSeed, opSeed, testAccountSeed(t), opSeed, userSeed, acctSeed, accountJWT)
+	}
+
+	accountBlock := regexp.MustCompile(`-----BEGIN NATS ACCOUNT JWT-----\n(\S+)\n------END NATS ACCOUNT JWT------`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("data.natsjwt_account.other.jwt"),
+				ExpectError: regexp.MustCompile(`Account Mismatch`),
+			},
+			{
+				Config:      config("null"),
+				ExpectError: regexp.MustCompile(`Missing Account JWT`),
+			},
+			{
+				Config: config("data.natsjwt_account.acct.jwt"),
+				Check: func(s *terraform.State) error {
+					resources := s.RootModule().Resources
+					user := resources["data.natsjwt_user.test"].Primary.Attributes
+					creds := []byte(user["creds"])
+
+					userJWT, err := natsjwt.ParseDecoratedJWT(creds)
+					if err != nil {
+						return fmt.Errorf("failed to parse user JWT from creds: %w", err)
+					}
+					if userJWT != user["jwt"] {
+						return fmt.Errorf("expected the first JWT block to be the user JWT")
+					}
+					kp, err := natsjwt.ParseDecoratedUserNKey(creds)
+					if err != nil {
+						return fmt.Errorf("failed to parse user seed from creds: %w", err)
+					}
+					if seed, _ := kp.Seed(); string(seed) != userSeed {
+						return fmt.Errorf("creds carry the wrong user seed")
+					}
+
+					m := accountBlock.FindSubmatch(creds)
+					if m == nil {
+						return fmt.Errorf("no account JWT block in creds:\n%s", creds)
+					}
+					if string(m[1]) != resources["data.natsjwt_account.acct"].Primary.Attributes["jwt"] {
+						return fmt.Errorf("account JWT block does not match the account JWT")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_MinValidity(t *testing.T) {
+	config := func(expires int64) string {
+		return fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name           = "short-lived"
+  seed           = %q
+  account_seed   = %q
+  expires        = %d
+  min_validity   = "1h"
+  reference_unix = 1700000000
+}
+`, testUserSeed(t), testAccountSeed(t), expires)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(1700000000 + 5*60),
+				ExpectError: regexp.MustCompile(`Insufficient Validity`),
+			},
+			{
+				Config: config(1700000000 + 2*60*60),
+				Check:  resource.TestCheckResourceAttr("data.natsjwt_user.test", "expires", "1700007200"),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_Signature(t *testing.T) {
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	config := fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "signed"
+  seed         = %q
+  account_seed = %q
+}
+`, testUserSeed(t), acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					attrs := s.RootModule().Resources["data.natsjwt_user.test"].Primary.Attributes
+					sig, err := base64.RawURLEncoding.DecodeString(attrs["signature"])
+					if err != nil {
+						return fmt.Errorf("failed to decode signature: %w", err)
+					}
+					jwtString := attrs["jwt"]
+					signed := jwtString[:strings.LastIndex(jwtString, ".")]
+					verifier, err := nkeys.FromPublicKey(acctPub)
+					if err != nil {
+						return err
+					}
+					if err := verifier.Verify([]byte(signed), sig); err != nil {
+						return fmt.Errorf("signature does not verify against the account key: %w", err)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &NkeySeedEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &NkeySeedEphemeralResource{}
+
+type NkeySeedEphemeralResource struct {
+	encryptionKey string
+}
+
+type NkeySeedEphemeralResourceModel struct {
+	SeedEncrypted types.String `tfsdk:"seed_encrypted"`
+	Seed          types.String `tfsdk:"seed"`
+	PublicKey     types.String `tfsdk:"public_key"`
+}
+
+func NewNkeySeedEphemeralResource() ephemeral.EphemeralResource {
+	return &NkeySeedEphemeralResource{}
+}
+
+func (r *NkeySeedEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nkey_seed"
+}
+
+func (r *NkeySeedEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Decrypts a seed_encrypted or signing_seed_encrypted value of a natsjwt_nkey resource with the provider's state_encryption_key. The plaintext seed is never stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"seed_encrypted": schema.StringAttribute{
+				Required:    true,
+				Description: "Encrypted seed, as produced by natsjwt_nkey.",
+			},
+			"seed": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The decrypted NKey seed.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key of the decrypted seed.",
+			},
+		},
+	}
+}
+
+func (r *NkeySeedEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*NatsjwtProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("Expected *NatsjwtProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.encryptionKey = data.StateEncryptionKey
+}
+
+func (r *NkeySeedEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data NkeySeedEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.encryptionKey == "" {
+		resp.Diagnostics.AddError("Missing State Encryption Key",
+			"The provider has no state_encryption_key, so seed_encrypted cannot be decrypted.")
+		return
+	}
+
+	seed, err := decryptSeed(r.encryptionKey, data.SeedEncrypted.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("seed_encrypted"), "Failed to Decrypt Seed",
+			fmt.Sprintf("Could not decrypt seed_encrypted with the configured state_encryption_key: %s", err))
+		return
+	}
+
+	pub, err := publicKeyFromSeed(seed)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("seed_encrypted"), "Invalid Seed",
+			fmt.Sprintf("seed_encrypted does not contain a valid NKey seed: %s", err))
+		return
+	}
+
+	data.Seed = types.StringValue(seed)
+	data.PublicKey = types.StringValue(pub)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
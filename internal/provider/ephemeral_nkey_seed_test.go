@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+func TestAccNkeySeedEphemeralResource_Decrypt(t *testing.T) {
+	// The encrypted account seed signs a user token without ever being
+	// written to state in plaintext.
+	config := `
+provider "natsjwt" {
+  state_encryption_key = "correct horse battery staple"
+}
+
+resource "natsjwt_nkey" "account" {
+  type = "account"
+}
+
+ephemeral "natsjwt_nkey_seed" "account" {
+  seed_encrypted = natsjwt_nkey.account.seed_encrypted
+}
+
+ephemeral "natsjwt_user_token" "ci" {
+  name         = "ci"
+  seed         = "%s"
+  account_seed = ephemeral.natsjwt_nkey_seed.account.seed
+  nonce        = "run-1"
+}
+
+provider "echo" {
+  data = {
+    public_key = ephemeral.natsjwt_nkey_seed.account.public_key
+    jwt        = ephemeral.natsjwt_user_token.ci.jwt
+  }
+}
+
+resource "echo" "test" {}
+`
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"natsjwt": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, testUserSeed(t)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.account", "seed"),
+					func(s *terraform.State) error {
+						accountPub := s.RootModule().Resources["natsjwt_nkey.account"].Primary.Attributes["public_key"]
+						attrs := s.RootModule().Resources["echo.test"].Primary.Attributes
+						if attrs["data.public_key"] != accountPub {
+							return fmt.Errorf("decrypted seed is for %s, want %s", attrs["data.public_key"], accountPub)
+						}
+						claims, err := natsjwt.DecodeUserClaims(attrs["data.jwt"])
+						if err != nil {
+							return fmt.Errorf("failed to decode user JWT: %w", err)
+						}
+						if claims.Issuer != accountPub {
+							return fmt.Errorf("expected user JWT issued by %s, got %s", accountPub, claims.Issuer)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccNkeySeedEphemeralResource_NoEncryptionKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+ephemeral "natsjwt_nkey_seed" "test" {
+  seed_encrypted = "AAAA"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing State Encryption Key`),
+			},
+		},
+	})
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/base32"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+var _ ephemeral.EphemeralResource = &UserTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &UserTokenEphemeralResource{}
+
+type UserTokenEphemeralResource struct {
+	settings jwtSettings
+}
+
+type UserTokenEphemeralResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Seed            types.String `tfsdk:"seed"`
+	AccountSeed     types.String `tfsdk:"account_seed"`
+	IssuerAccount   types.String `tfsdk:"issuer_account"`
+	Nonce           types.String `tfsdk:"nonce"`
+	IssuedAt        types.Int64  `tfsdk:"issued_at"`
+	IssuedAtRFC3339 types.String `tfsdk:"issued_at_rfc3339"`
+	Expires         types.Int64  `tfsdk:"expires"`
+	MinValidity     types.String `tfsdk:"min_validity"`
+	ReferenceUnix   types.Int64  `tfsdk:"reference_unix"`
+	BearerToken     types.Bool   `tfsdk:"bearer_token"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	JWTID           types.String `tfsdk:"jwt_id"`
+	JWT             types.String `tfsdk:"jwt"`
+	Creds           types.String `tfsdk:"creds"`
+}
+
+func NewUserTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &UserTokenEphemeralResource{}
+}
+
+func (r *UserTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_token"
+}
+
+func (r *UserTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a short-lived user JWT and creds that are never stored in plan or state. The nonce is folded into the JWT ID so each run can get a distinct, reproducible token.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "User name.",
+				Validators:  []validator.String{NameValidator()},
+			},
+			"seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "User NKey seed (starts with SU).",
+				Validators:  []validator.String{SeedTypeValidator(nkeys.PrefixByteUser)},
+			},
+			"account_seed": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Account or signing key seed used to sign the user JWT (starts with SA).",
+				Validators:  []validator.String{SeedTypeValidator(nkeys.PrefixByteAccount)},
+			},
+			"issuer_account": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account public key. Set this when using a signing key instead of the account key directly.",
+				Validators:  []validator.String{PublicKeyTypeValidator(nkeys.PrefixByteAccount)},
+			},
+			"nonce": schema.StringAttribute{
+				Required:    true,
+				Description: "Per-run value, e.g. a CI run ID. The same nonce and inputs reproduce the same JWT.",
+			},
+			"issued_at": schema.Int64Attribute{
+				Optional:    true,
+				Description: "JWT issued-at timestamp as Unix seconds. Defaults to 0 (Unix epoch).",
+			},
+			"issued_at_rfc3339": schema.StringAttribute{
+				Optional:    true,
+				Description: "JWT issued-at timestamp in RFC 3339 format. Alternative to issued_at; setting both is an error.",
+			},
+			"expires": schema.Int64Attribute{
+				Optional:    true,
+				Description: "JWT expiration timestamp as Unix seconds. Defaults to no expiration.",
+			},
+			"min_validity": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum time the JWT must remain valid after reference_unix, as a Go duration such as 1h. Opening fails when expires is sooner. Ignored when expires is not set.",
+			},
+			"reference_unix": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Unix timestamp min_validity is measured from. Defaults to the current time.",
+			},
+			"bearer_token": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Issue a bearer token that does not require the seed to connect. Default false.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The user public key.",
+			},
+			"jwt_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The JWT ID (jti), derived from the user public key and nonce.",
+			},
+			"jwt": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The signed user JWT.",
+			},
+			"creds": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "NATS credentials file content.",
+			},
+		},
+	}
+}
+
+func (r *UserTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*NatsjwtProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("Expected *NatsjwtProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.settings.maxJWTSize = data.MaxJWTSize
+	r.settings.issuedAtRoundTo = data.IssuedAtRoundTo
+}
+
+func (r *UserTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data UserTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Built like natsjwt_user, with only the token's own settings.
+	userResp := &datasource.ReadResponse{}
+	claims, userPub, err := buildUserClaims(ctx, &UserDataSourceModel{
+		Name:            data.Name,
+		Seed:            data.Seed,
+		AccountSeed:     data.AccountSeed,
+		IssuerAccount:   data.IssuerAccount,
+		IssuedAt:        data.IssuedAt,
+		IssuedAtRFC3339: data.IssuedAtRFC3339,
+		Expires:         data.Expires,
+		MinValidity:     data.MinValidity,
+		ReferenceUnix:   data.ReferenceUnix,
+		BearerToken:     data.BearerToken,
+	}, r.settings.issuedAtRoundTo, userResp)
+	resp.Diagnostics.Append(userResp.Diagnostics...)
+	if err != nil || resp.Diagnostics.HasError() {
+		return
+	}
+	claims.ID = nonceJWTID(userPub, data.Nonce.ValueString())
+
+	accountKP, err := keypairFromSeed(data.AccountSeed.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Account Seed", fmt.Sprintf("Failed to parse account seed: %s", err))
+		return
+	}
+
+	jwtString, err := encodeDeterministic(claims, accountKP)
+	if err != nil {
+		resp.Diagnostics.AddError("JWT Encoding Error", fmt.Sprintf("Failed to encode user JWT: %s", err))
+		return
+	}
+
+	r.settings.checkJWTSize("user", jwtString, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credsBytes, err := natsjwt.FormatUserConfig(jwtString, []byte(data.Seed.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Credentials Encoding Error", fmt.Sprintf("Failed to encode user credentials: %s", err))
+		return
+	}
+
+	data.PublicKey = types.StringValue(userPub)
+	data.JWTID = types.StringValue(claims.ID)
+	data.JWT = types.StringValue(jwtString)
+	data.Creds = types.StringValue(string(credsBytes))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// nonceJWTID derives a JWT ID from the subject and nonce, in the same base32
+// SHA-512/256 form the jwt library uses for its own IDs.
+func nonceJWTID(subject, nonce string) string {
+	sum := sha512.Sum512_256([]byte(subject + "\n" + nonce))
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(sum[:])
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+func TestAccUserTokenEphemeralResource_Nonce(t *testing.T) {
+	userSeed := testUserSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	token := func(name, nonce string) string {
+		return fmt.Sprintf(`
+ephemeral "natsjwt_user_token" %q {
+  name         = "ci"
+  seed         = %q
+  account_seed = %q
+  nonce        = %q
+  expires      = 4000000000
+}
+`, name, userSeed, acctSeed, nonce)
+	}
+
+	config := token("first", "run-1") + token("second", "run-2") + token("repeat", "run-1") + `
+provider "echo" {
+  data = {
+    first     = ephemeral.natsjwt_user_token.first.jwt_id
+    second    = ephemeral.natsjwt_user_token.second.jwt_id
+    repeat    = ephemeral.natsjwt_user_token.repeat.jwt_id
+    first_jwt = ephemeral.natsjwt_user_token.first.jwt
+  }
+}
+
+resource "echo" "test" {}
+`
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"natsjwt": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					attrs := s.RootModule().Resources["echo.test"].Primary.Attributes
+					first, second, repeat := attrs["data.first"], attrs["data.second"], attrs["data.repeat"]
+					if first == "" || first == second {
+						return fmt.Errorf("expected distinct jwt_ids for different nonces, got %q and %q", first, second)
+					}
+					if first != repeat {
+						return fmt.Errorf("expected the same jwt_id for the same nonce, got %q and %q", first, repeat)
+					}
+					claims, err := natsjwt.DecodeUserClaims(attrs["data.first_jwt"])
+					if err != nil {
+						return fmt.Errorf("failed to decode user JWT: %w", err)
+					}
+					if claims.ID != first {
+						return fmt.Errorf("expected jti %q, got %q", first, claims.ID)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccUserTokenEphemeralResource_SharedUserBuilder(t *testing.T) {
+	config := fmt.Sprintf(`
+ephemeral "natsjwt_user_token" "ci" {
+  name         = "ci"
+  seed         = %q
+  account_seed = %q
+  nonce        = "run-1"
+
+  issued_at_rfc3339 = "2023-11-14T22:13:20Z"
+  expires           = 1700003600
+  min_validity      = "30m"
+  reference_unix    = 1700000000
+}
+
+provider "echo" {
+  data = {
+    jwt = ephemeral.natsjwt_user_token.ci.jwt
+  }
+}
+
+resource "echo" "test" {}
+`, testUserSeed(t), testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"natsjwt": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					claims, err := natsjwt.DecodeUserClaims(s.RootModule().Resources["echo.test"].Primary.Attributes["data.jwt"])
+					if err != nil {
+						return fmt.Errorf("failed to decode user JWT: %w", err)
+					}
+					if claims.IssuedAt != 1700000000 {
+						return fmt.Errorf("expected iat 1700000000 from issued_at_rfc3339, got %d", claims.IssuedAt)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccUserTokenEphemeralResource_MinValidity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+ephemeral "natsjwt_user_token" "ci" {
+  name           = "ci"
+  seed           = %q
+  account_seed   = %q
+  nonce          = "run-1"
+  expires        = 1700003600
+  min_validity   = "2h"
+  reference_unix = 1700000000
+}
+`, testUserSeed(t), testAccountSeed(t)),
+				ExpectError: regexp.MustCompile(`Insufficient Validity`),
+			},
+		},
+	})
+}
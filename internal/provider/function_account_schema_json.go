@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ function.Function = &accountSchemaJSONFunction{}
+
+func NewAccountSchemaJSONFunction() function.Function {
+	return &accountSchemaJSONFunction{}
+}
+
+type accountSchemaJSONFunction struct{}
+
+func (f *accountSchemaJSONFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "account_schema_json"
+}
+
+func (f *accountSchemaJSONFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Describes the attributes of the natsjwt_account data source as JSON.",
+		Description: "Returns a JSON object with the name, type, description and flags of every natsjwt_account attribute, including nested attributes. It is generated from the data source schema, so it always matches the provider version.",
+		Return:      function.StringReturn{},
+	}
+}
+
+func (f *accountSchemaJSONFunction) Run(ctx context.Context, _ function.RunRequest, resp *function.RunResponse) {
+	out, err := json.Marshal(struct {
+		Attributes map[string]schemaAttributeDescription `json:"attributes"`
+	}{describeSchemaAttributes(accountSchemaAttributes())})
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("failed to marshal account schema: %s", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, string(out))
+}
+
+// schemaAttributeDescription is the JSON form of one schema attribute.
+type schemaAttributeDescription struct {
+	Type        string                                `json:"type"`
+	Description string                                `json:"description"`
+	Required    bool                                  `json:"required"`
+	Optional    bool                                  `json:"optional"`
+	Computed    bool                                  `json:"computed"`
+	Sensitive   bool                                  `json:"sensitive"`
+	Attributes  map[string]schemaAttributeDescription `json:"attributes,omitempty"`
+}
+
+// describeSchemaAttributes converts data source schema attributes, recursing into
+// nested attributes.
+func describeSchemaAttributes(attrs map[string]schema.Attribute) map[string]schemaAttributeDescription {
+	out := make(map[string]schemaAttributeDescription, len(attrs))
+	for name, a := range attrs {
+		d := schemaAttributeDescription{
+			Type:        schemaTypeName(a.GetType()),
+			Description: a.GetDescription(),
+			Required:    a.IsRequired(),
+			Optional:    a.IsOptional(),
+			Computed:    a.IsComputed(),
+			Sensitive:   a.IsSensitive(),
+		}
+		switch n := a.(type) {
+		case schema.SingleNestedAttribute:
+			d.Attributes = describeSchemaAttributes(n.Attributes)
+		case schema.ListNestedAttribute:
+			d.Attributes = describeSchemaAttributes(n.NestedObject.Attributes)
+		case schema.MapNestedAttribute:
+			d.Attributes = describeSchemaAttributes(n.NestedObject.Attributes)
+		case schema.SetNestedAttribute:
+			d.Attributes = describeSchemaAttributes(n.NestedObject.Attributes)
+		}
+		out[name] = d
+	}
+	return out
+}
+
+// schemaTypeName renders a framework type in Terraform type syntax, e.g. list(string).
+// Object attribute types are not expanded.
+func schemaTypeName(t attr.Type) string {
+	switch t := t.(type) {
+	case basetypes.StringType:
+		return "string"
+	case basetypes.Int64Type, basetypes.NumberType, basetypes.Float64Type:
+		return "number"
+	case basetypes.BoolType:
+		return "bool"
+	case basetypes.ListType:
+		return fmt.Sprintf("list(%s)", schemaTypeName(t.ElemType))
+	case basetypes.SetType:
+		return fmt.Sprintf("set(%s)", schemaTypeName(t.ElemType))
+	case basetypes.MapType:
+		return fmt.Sprintf("map(%s)", schemaTypeName(t.ElemType))
+	case basetypes.ObjectType:
+		return "object"
+	default:
+		return t.String()
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAccountSchemaJSONFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "schema" {
+  value = provider::natsjwt::account_schema_json()
+}
+
+output "jetstream_type" {
+  value = jsondecode(provider::natsjwt::account_schema_json()).attributes.jetstream_limits.type
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("jetstream_type", "list(object)"),
+					func(s *terraform.State) error {
+						var described struct {
+							Attributes map[string]schemaAttributeDescription `json:"attributes"`
+						}
+						raw := s.RootModule().Outputs["schema"].Value.(string)
+						if err := json.Unmarshal([]byte(raw), &described); err != nil {
+							return fmt.Errorf("output is not valid JSON: %w", err)
+						}
+						js, ok := described.Attributes["jetstream_limits"]
+						if !ok {
+							return fmt.Errorf("expected jetstream_limits in %v", described.Attributes)
+						}
+						if !js.Optional || js.Attributes["tier"].Type != "string" {
+							return fmt.Errorf("unexpected jetstream_limits description: %+v", js)
+						}
+						if seed := described.Attributes["seed"]; !seed.Required || !seed.Sensitive {
+							return fmt.Errorf("expected seed to be required and sensitive, got %+v", seed)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nats-io/nkeys"
+)
+
+var _ function.Function = &configAccountsFunction{}
+
+func NewConfigAccountsFunction() function.Function {
+	return &configAccountsFunction{}
+}
+
+type configAccountsFunction struct{}
+
+func (f *configAccountsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "config_accounts"
+}
+
+func (f *configAccountsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Lists the accounts preloaded by a NATS server config.",
+		Description: "Parses the resolver_preload block of a server config, such as the server_config output of natsjwt_config_helper, and returns the account public keys in the order they appear. Returns an empty list when the config has no resolver_preload block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "config",
+				Description: "NATS server config content.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *configAccountsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var config string
+	resp.Error = req.Arguments.Get(ctx, &config)
+	if resp.Error != nil {
+		return
+	}
+
+	accounts, err := preloadedAccounts(config)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, accounts)
+}
+
+// preloadedAccounts returns the account public keys listed in the resolver_preload
+// block of a server config. Entries take the form "<key>: <jwt>", with ":" or "="
+// as separator and optionally quoted keys, separated by newlines or commas.
+func preloadedAccounts(config string) ([]string, error) {
+	accounts := []string{}
+
+	start := strings.Index(config, "resolver_preload")
+	if start < 0 {
+		return accounts, nil
+	}
+	open := strings.Index(config[start:], "{")
+	if open < 0 {
+		return nil, fmt.Errorf("resolver_preload is not followed by a block")
+	}
+	block := config[start+open+1:]
+	end := strings.Index(block, "}")
+	if end < 0 {
+		return nil, fmt.Errorf("resolver_preload block is not closed")
+	}
+	block = block[:end]
+
+	entries := strings.FieldsFunc(block, func(r rune) bool { return r == '\n' || r == ',' })
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") || strings.HasPrefix(entry, "//") {
+			continue
+		}
+		if strings.HasPrefix(entry, "include ") {
+			return nil, fmt.Errorf("resolver_preload uses %q; includes cannot be resolved, pass the combined config instead", entry)
+		}
+		key, _, found := strings.Cut(entry, ":")
+		if !found {
+			key, _, found = strings.Cut(entry, "=")
+		}
+		if !found {
+			key, _, found = strings.Cut(entry, " ")
+		}
+		if !found {
+			return nil, fmt.Errorf("resolver_preload entry %q has no value", entry)
+		}
+		key = strings.Trim(strings.TrimSpace(key), `"'`)
+		if !nkeys.IsValidPublicAccountKey(key) {
+			return nil, fmt.Errorf("resolver_preload key %q is not an account public key", key)
+		}
+		accounts = append(accounts, key)
+	}
+
+	return accounts, nil
+}
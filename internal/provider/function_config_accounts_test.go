@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigAccountsFunction_TwoAccounts(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acct1Seed := testAccountSeed(t)
+	acct2Seed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "test" {
+  name = "op"
+  seed = %q
+}
+
+data "natsjwt_account" "one" {
+  name          = "one"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_account" "two" {
+  name          = "two"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_config_helper" "test" {
+  operator_jwt = data.natsjwt_operator.test.jwt
+  account_jwts = [data.natsjwt_account.one.jwt, data.natsjwt_account.two.jwt]
+}
+
+locals {
+  accounts = provider::natsjwt::config_accounts(data.natsjwt_config_helper.test.server_config)
+}
+
+output "count" {
+  value = length(local.accounts)
+}
+
+output "matches" {
+  value = sort(local.accounts) == sort([data.natsjwt_account.one.public_key, data.natsjwt_account.two.public_key])
+}
+`, opSeed, acct1Seed, opSeed, acct2Seed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "2"),
+					resource.TestCheckOutput("matches", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigAccountsFunction_NoPreload(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "count" {
+  value = length(provider::natsjwt::config_accounts("resolver: MEMORY\n"))
+}
+`,
+				Check: resource.TestCheckOutput("count", "0"),
+			},
+		},
+	})
+}
+
+func TestAccConfigAccountsFunction_Include(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "accounts" {
+  value = provider::natsjwt::config_accounts("resolver_preload: {\n  include \"sys.conf\"\n}\n")
+}
+`,
+				ExpectError: regexp.MustCompile(`includes cannot be resolved`),
+			},
+		},
+	})
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &credsPathFunction{}
+
+func NewCredsPathFunction() function.Function {
+	return &credsPathFunction{}
+}
+
+type credsPathFunction struct{}
+
+func (f *credsPathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "creds_path"
+}
+
+func (f *credsPathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the nsc-style relative path of a user creds file.",
+		Description: "Returns creds/<operator>/<account>/<user>.creds. Names must not be empty, contain path separators, or be . or ..",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "operator_name",
+				Description: "Operator name.",
+			},
+			function.StringParameter{
+				Name:        "account_name",
+				Description: "Account name.",
+			},
+			function.StringParameter{
+				Name:        "user_name",
+				Description: "User name.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *credsPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var operatorName, accountName, userName string
+	resp.Error = req.Arguments.Get(ctx, &operatorName, &accountName, &userName)
+	if resp.Error != nil {
+		return
+	}
+
+	for i, name := range []string{operatorName, accountName, userName} {
+		if err := validatePathSegment(name); err != nil {
+			resp.Error = function.NewArgumentFuncError(int64(i), fmt.Sprintf("invalid name %q: %s", name, err))
+			return
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, path.Join("creds", operatorName, accountName, userName+".creds"))
+}
+
+// validatePathSegment rejects names that would not map to a single directory entry.
+func validatePathSegment(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("name is empty")
+	case name == "." || name == "..":
+		return fmt.Errorf("name must not be a relative path element")
+	case strings.ContainsAny(name, "/\\"):
+		return fmt.Errorf("name must not contain path separators")
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCredsPathFunction_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "path" {
+  value = provider::natsjwt::creds_path("main", "app", "web-1")
+}
+`,
+				Check: resource.TestCheckOutput("path", "creds/main/app/web-1.creds"),
+			},
+		},
+	})
+}
+
+func TestAccCredsPathFunction_RejectsSlash(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "path" {
+  value = provider::natsjwt::creds_path("main", "../app", "web-1")
+}
+`,
+				ExpectError: regexp.MustCompile(`not contain path separators`),
+			},
+		},
+	})
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &expiryAtFunction{}
+
+func NewExpiryAtFunction() function.Function {
+	return &expiryAtFunction{}
+}
+
+type expiryAtFunction struct{}
+
+func (f *expiryAtFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "expiry_at"
+}
+
+func (f *expiryAtFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Adds a duration to an issued-at Unix timestamp.",
+		Description: "Returns issued_at plus duration in seconds, for use as a deterministic expires value. The duration uses Go syntax (e.g. 24h, 90m) and must be a non-negative whole number of seconds.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "issued_at",
+				Description: "Issued-at Unix timestamp.",
+			},
+			function.StringParameter{
+				Name:        "duration",
+				Description: "Duration to add, e.g. 24h.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *expiryAtFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var issuedAt int64
+	var durationStr string
+	resp.Error = req.Arguments.Get(ctx, &issuedAt, &durationStr)
+	if resp.Error != nil {
+		return
+	}
+
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid duration %q: %s", durationStr, err))
+		return
+	}
+	if duration < 0 || duration%time.Second != 0 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("duration %q must be a non-negative whole number of seconds", durationStr))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, issuedAt+int64(duration/time.Second))
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccExpiryAtFunction_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "expires" {
+  value = provider::natsjwt::expiry_at(1000, "24h")
+}
+`,
+				Check: resource.TestCheckOutput("expires", "87400"),
+			},
+		},
+	})
+}
+
+func TestAccExpiryAtFunction_InvalidDuration(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "expires" {
+  value = provider::natsjwt::expiry_at(1000, "one day")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid duration`),
+			},
+			{
+				Config: `
+output "expires" {
+  value = provider::natsjwt::expiry_at(1000, "1500ms")
+}
+`,
+				ExpectError: regexp.MustCompile(`whole number of seconds`),
+			},
+		},
+	})
+}
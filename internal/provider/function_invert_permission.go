@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &invertPermissionFunction{}
+
+func NewInvertPermissionFunction() function.Function {
+	return &invertPermissionFunction{}
+}
+
+type invertPermissionFunction struct{}
+
+func (f *invertPermissionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "invert_permission"
+}
+
+func (f *invertPermissionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the subjects of a universe list that are not in an allow list.",
+		Description: "Builds a deny list as the complement of an allow list. Subjects are compared as exact strings, not by wildcard matching. The result keeps the order of universe and drops duplicates.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "allow",
+				ElementType: types.StringType,
+				Description: "Subjects to leave out of the result.",
+			},
+			function.ListParameter{
+				Name:        "universe",
+				ElementType: types.StringType,
+				Description: "All subjects to consider.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *invertPermissionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var allow, universe []string
+	resp.Error = req.Arguments.Get(ctx, &allow, &universe)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, invertSubjects(allow, universe))
+}
+
+// invertSubjects returns the subjects of universe missing from allow, in
+// universe order and without duplicates.
+func invertSubjects(allow, universe []string) []string {
+	skip := make(map[string]bool, len(allow)+len(universe))
+	for _, s := range allow {
+		skip[s] = true
+	}
+	result := []string{}
+	for _, s := range universe {
+		if skip[s] {
+			continue
+		}
+		skip[s] = true
+		result = append(result, s)
+	}
+	return result
+}
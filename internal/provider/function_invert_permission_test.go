@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInvertPermissionFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "deny" {
+  value = join(",", provider::natsjwt::invert_permission(["a.>"], ["a.>", "b.>"]))
+}
+
+output "ordered" {
+  value = join(",", provider::natsjwt::invert_permission(["b.>"], ["c.>", "b.>", "a.>", "c.>"]))
+}
+
+output "nothing_left" {
+  value = join(",", provider::natsjwt::invert_permission(["a.>", "b.>"], ["a.>", "b.>"]))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("deny", "b.>"),
+					resource.TestCheckOutput("ordered", "c.>,a.>"),
+					resource.TestCheckOutput("nothing_left", ""),
+				),
+			},
+		},
+	})
+}
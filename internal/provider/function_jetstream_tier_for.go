@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ function.Function = &jetStreamTierForFunction{}
+
+func NewJetStreamTierForFunction() function.Function {
+	return &jetStreamTierForFunction{}
+}
+
+type jetStreamTierForFunction struct{}
+
+func (f *jetStreamTierForFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jetstream_tier_for"
+}
+
+func (f *jetStreamTierForFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the JetStream tier of a NATS account JWT that applies to a replication count.",
+		Description: "Returns R<replicas> when the account has tiered JetStream limits for that replication count, or \"global\" when it has no such tier.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "account_jwt",
+				Description: "NATS account JWT to read JetStream tiers from.",
+			},
+			function.Int64Parameter{
+				Name:        "replicas",
+				Description: "Stream replication count, at least 1.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *jetStreamTierForFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token string
+	var replicas int64
+	resp.Error = req.Arguments.Get(ctx, &token, &replicas)
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := natsjwt.DecodeAccountClaims(token)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("failed to decode account JWT: %s", err))
+		return
+	}
+	if replicas < 1 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("replicas must be at least 1, got %d", replicas))
+		return
+	}
+
+	tier := fmt.Sprintf("R%d", replicas)
+	if _, ok := claims.Limits.JetStreamTieredLimits[tier]; !ok {
+		tier = "global"
+	}
+
+	resp.Error = resp.Result.Set(ctx, tier)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJetStreamTierForFunction_Tiered(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "tiered"
+  seed          = %q
+  operator_seed = %q
+  jetstream_limits = [
+    {
+      tier         = "R1"
+      disk_storage = 1024
+    },
+    {
+      tier         = "R3"
+      disk_storage = 4096
+    }
+  ]
+}
+
+output "r3" {
+  value = provider::natsjwt::jetstream_tier_for(data.natsjwt_account.test.jwt, 3)
+}
+
+output "r5" {
+  value = provider::natsjwt::jetstream_tier_for(data.natsjwt_account.test.jwt, 5)
+}
+`, testAccountSeed(t), testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("r3", "R3"),
+					resource.TestCheckOutput("r5", "global"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJetStreamTierForFunction_InvalidReplicas(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "plain"
+  seed          = %q
+  operator_seed = %q
+}
+
+output "tier" {
+  value = provider::natsjwt::jetstream_tier_for(data.natsjwt_account.test.jwt, 0)
+}
+`, testAccountSeed(t), testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`replicas must be at least 1`),
+			},
+		},
+	})
+}
+
+func TestAccJetStreamTierForFunction_InvalidJWT(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "tier" {
+  value = provider::natsjwt::jetstream_tier_for("not-a-jwt", 1)
+}
+`,
+				ExpectError: regexp.MustCompile(`failed to decode account JWT`),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ function.Function = &jwtExportsFunction{}
+
+func NewJWTExportsFunction() function.Function {
+	return &jwtExportsFunction{}
+}
+
+type jwtExportsFunction struct{}
+
+type jwtExportModel struct {
+	Name    types.String `tfsdk:"name"`
+	Subject types.String `tfsdk:"subject"`
+	Type    types.String `tfsdk:"type"`
+}
+
+var jwtExportAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"subject": types.StringType,
+	"type":    types.StringType,
+}
+
+func (f *jwtExportsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jwt_exports"
+}
+
+func (f *jwtExportsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Lists the exports declared by a NATS account JWT.",
+		Description: "Returns a list of objects with the name, subject and type (service or stream) of each export in the account JWT.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "jwt",
+				Description: "NATS account JWT to read exports from.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: jwtExportAttrTypes},
+		},
+	}
+}
+
+func (f *jwtExportsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token string
+	resp.Error = req.Arguments.GetArgument(ctx, 0, &token)
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := natsjwt.DecodeAccountClaims(token)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("failed to decode account JWT: %s", err))
+		return
+	}
+
+	exports := make([]jwtExportModel, 0, len(claims.Exports))
+	for _, exp := range claims.Exports {
+		exports = append(exports, jwtExportModel{
+			Name:    types.StringValue(exp.Name),
+			Subject: types.StringValue(string(exp.Subject)),
+			Type:    types.StringValue(exp.Type.String()),
+		})
+	}
+
+	resp.Error = resp.Result.Set(ctx, exports)
+}
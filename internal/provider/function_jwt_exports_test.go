@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJWTExportsFunction_SystemAccount(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_system_account" "test" {
+  name          = "SYS"
+  seed          = %q
+  operator_seed = %q
+}
+
+locals {
+  exports = provider::natsjwt::jwt_exports(data.natsjwt_system_account.test.jwt)
+}
+
+output "export_count" {
+  value = length(local.exports)
+}
+
+output "service_subject" {
+  value = one([for e in local.exports : e.subject if e.type == "service"])
+}
+
+output "stream_subject" {
+  value = one([for e in local.exports : e.subject if e.type == "stream"])
+}
+`, acctSeed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("export_count", "2"),
+					resource.TestCheckOutput("service_subject", "$SYS.REQ.ACCOUNT.*.*"),
+					resource.TestCheckOutput("stream_subject", "$SYS.ACCOUNT.*.>"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJWTExportsFunction_InvalidJWT(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "exports" {
+  value = provider::natsjwt::jwt_exports("not-a-jwt")
+}
+`,
+				ExpectError: regexp.MustCompile(`failed to decode account JWT`),
+			},
+		},
+	})
+}
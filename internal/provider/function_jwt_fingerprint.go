@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ function.Function = &jwtFingerprintFunction{}
+
+func NewJWTFingerprintFunction() function.Function {
+	return &jwtFingerprintFunction{}
+}
+
+type jwtFingerprintFunction struct{}
+
+func (f *jwtFingerprintFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jwt_fingerprint"
+}
+
+func (f *jwtFingerprintFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Computes a fingerprint of a NATS JWT's claims that ignores timestamps.",
+		Description: "Returns the hex-encoded SHA-256 of the JWT claims with iat, nbf, exp and jti cleared, so JWTs that differ only in timestamps or ID share a fingerprint. The signature is not part of the fingerprint.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "jwt",
+				Description: "NATS JWT.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *jwtFingerprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var jwtStr string
+	resp.Error = req.Arguments.GetArgument(ctx, 0, &jwtStr)
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := natsjwt.DecodeGeneric(jwtStr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("failed to decode JWT: %s", err))
+		return
+	}
+
+	claims.IssuedAt = 0
+	claims.NotBefore = 0
+	claims.Expires = 0
+	claims.ID = ""
+
+	// Map keys are marshalled in sorted order, so equal claims give equal bytes.
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("failed to marshal JWT claims: %s", err))
+		return
+	}
+
+	sum := sha256.Sum256(payload)
+	resp.Error = resp.Result.Set(ctx, hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJWTFingerprintFunction_IgnoresTimestamps(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	account := func(label, issuedAt, pubAllow string) string {
+		return fmt.Sprintf(`
+data "natsjwt_account" %q {
+  name          = "app"
+  seed          = %q
+  operator_seed = %q
+  issued_at     = %s
+  default_permissions = {
+    pub_allow = [%q]
+  }
+}
+`, label, acctSeed, opSeed, issuedAt, pubAllow)
+	}
+
+	config := account("original", "100", "app.>") +
+		account("reissued", "200", "app.>") +
+		account("changed", "100", "other.>") + `
+output "same_permissions" {
+  value = provider::natsjwt::jwt_fingerprint(data.natsjwt_account.original.jwt) == provider::natsjwt::jwt_fingerprint(data.natsjwt_account.reissued.jwt)
+}
+
+output "jwts_differ" {
+  value = data.natsjwt_account.original.jwt != data.natsjwt_account.reissued.jwt
+}
+
+output "changed_permissions" {
+  value = provider::natsjwt::jwt_fingerprint(data.natsjwt_account.original.jwt) == provider::natsjwt::jwt_fingerprint(data.natsjwt_account.changed.jwt)
+}
+
+output "fingerprint" {
+  value = provider::natsjwt::jwt_fingerprint(data.natsjwt_account.original.jwt)
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("jwts_differ", "true"),
+					resource.TestCheckOutput("same_permissions", "true"),
+					resource.TestCheckOutput("changed_permissions", "false"),
+					resource.TestMatchOutput("fingerprint", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJWTFingerprintFunction_InvalidJWT(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "fingerprint" {
+  value = provider::natsjwt::jwt_fingerprint("not-a-jwt")
+}
+`,
+				ExpectError: regexp.MustCompile(`failed to decode JWT`),
+			},
+		},
+	})
+}
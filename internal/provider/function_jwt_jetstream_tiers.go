@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ function.Function = &jwtJetStreamTiersFunction{}
+
+func NewJWTJetStreamTiersFunction() function.Function {
+	return &jwtJetStreamTiersFunction{}
+}
+
+type jwtJetStreamTiersFunction struct{}
+
+type jwtJetStreamTierModel struct {
+	MemStorage  types.Int64 `tfsdk:"mem_storage"`
+	DiskStorage types.Int64 `tfsdk:"disk_storage"`
+	Streams     types.Int64 `tfsdk:"streams"`
+	Consumer    types.Int64 `tfsdk:"consumer"`
+}
+
+var jwtJetStreamTierAttrTypes = map[string]attr.Type{
+	"mem_storage":  types.Int64Type,
+	"disk_storage": types.Int64Type,
+	"streams":      types.Int64Type,
+	"consumer":     types.Int64Type,
+}
+
+func (f *jwtJetStreamTiersFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jwt_jetstream_tiers"
+}
+
+func (f *jwtJetStreamTiersFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Lists the per-replication-tier JetStream limits of a NATS account JWT.",
+		Description: "Returns a map of tier name (e.g. R1, R3) to an object with mem_storage, disk_storage, streams and consumer. Accounts with only global limits, or without JetStream, return an empty map.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "jwt",
+				Description: "NATS account JWT to read JetStream tiers from.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ObjectType{AttrTypes: jwtJetStreamTierAttrTypes},
+		},
+	}
+}
+
+func (f *jwtJetStreamTiersFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token string
+	resp.Error = req.Arguments.GetArgument(ctx, 0, &token)
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := natsjwt.DecodeAccountClaims(token)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("failed to decode account JWT: %s", err))
+		return
+	}
+
+	tiers := make(map[string]jwtJetStreamTierModel, len(claims.Limits.JetStreamTieredLimits))
+	for tier, limits := range claims.Limits.JetStreamTieredLimits {
+		tiers[tier] = jwtJetStreamTierModel{
+			MemStorage:  types.Int64Value(limits.MemoryStorage),
+			DiskStorage: types.Int64Value(limits.DiskStorage),
+			Streams:     types.Int64Value(limits.Streams),
+			Consumer:    types.Int64Value(limits.Consumer),
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, tiers)
+}
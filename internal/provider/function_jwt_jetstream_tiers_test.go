@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJWTJetStreamTiersFunction_TwoTiers(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "tiered"
+  seed          = %q
+  operator_seed = %q
+  jetstream_limits = [
+    {
+      tier         = "R1"
+      mem_storage  = 1024
+      disk_storage = 2048
+      streams      = 5
+      consumer     = 10
+    },
+    {
+      tier         = "R3"
+      mem_storage  = 4096
+      disk_storage = 8192
+    }
+  ]
+}
+
+locals {
+  tiers = provider::natsjwt::jwt_jetstream_tiers(data.natsjwt_account.test.jwt)
+}
+
+output "tier_names" {
+  value = join(",", sort(keys(local.tiers)))
+}
+
+output "r1" {
+  value = jsonencode(local.tiers["R1"])
+}
+
+output "r3" {
+  value = jsonencode(local.tiers["R3"])
+}
+`, testAccountSeed(t), testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("tier_names", "R1,R3"),
+					resource.TestCheckOutput("r1", `{"consumer":10,"disk_storage":2048,"mem_storage":1024,"streams":5}`),
+					resource.TestCheckOutput("r3", `{"consumer":-1,"disk_storage":8192,"mem_storage":4096,"streams":-1}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJWTJetStreamTiersFunction_GlobalOnly(t *testing.T) {
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "global"
+  seed          = %q
+  operator_seed = %q
+  jetstream_limits = [{
+    mem_storage = 1024
+  }]
+}
+
+output "tier_count" {
+  value = length(provider::natsjwt::jwt_jetstream_tiers(data.natsjwt_account.test.jwt))
+}
+`, testAccountSeed(t), testOperatorSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckOutput("tier_count", "0"),
+			},
+		},
+	})
+}
+
+func TestAccJWTJetStreamTiersFunction_InvalidJWT(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "tiers" {
+  value = provider::natsjwt::jwt_jetstream_tiers("not-a-jwt")
+}
+`,
+				ExpectError: regexp.MustCompile(`failed to decode account JWT`),
+			},
+		},
+	})
+}
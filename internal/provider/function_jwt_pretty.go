@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ function.Function = &jwtPrettyFunction{}
+
+func NewJWTPrettyFunction() function.Function {
+	return &jwtPrettyFunction{}
+}
+
+type jwtPrettyFunction struct{}
+
+func (f *jwtPrettyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jwt_pretty"
+}
+
+func (f *jwtPrettyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the claims of a NATS JWT as indented JSON.",
+		Description: "Decodes any NATS JWT (operator, account, user, activation) and returns its claims as indented JSON, for troubleshooting.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "jwt",
+				Description: "NATS JWT.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *jwtPrettyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var jwtStr string
+	resp.Error = req.Arguments.GetArgument(ctx, 0, &jwtStr)
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := natsjwt.DecodeGeneric(jwtStr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("failed to decode JWT: %s", err))
+		return
+	}
+
+	pretty, err := json.MarshalIndent(claims, "", "  ")
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("failed to format JWT claims: %s", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, string(pretty))
+}
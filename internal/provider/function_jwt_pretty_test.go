@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccJWTPrettyFunction_Basic(t *testing.T) {
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	opSeed := testOperatorSeed(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "pretty-acct"
+  seed          = %q
+  operator_seed = %q
+}
+
+output "pretty" {
+  value = provider::natsjwt::jwt_pretty(data.natsjwt_account.test.jwt)
+}
+`, acctSeed, opSeed),
+				Check: func(s *terraform.State) error {
+					out, ok := s.RootModule().Outputs["pretty"]
+					if !ok {
+						return fmt.Errorf("output pretty not found")
+					}
+					var claims map[string]any
+					if err := json.Unmarshal([]byte(out.Value.(string)), &claims); err != nil {
+						return fmt.Errorf("output is not valid JSON: %w", err)
+					}
+					if claims["sub"] != acctPub {
+						return fmt.Errorf("expected sub %s, got %v", acctPub, claims["sub"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccJWTPrettyFunction_InvalidJWT(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "pretty" {
+  value = provider::natsjwt::jwt_pretty("not-a-jwt")
+}
+`,
+				ExpectError: regexp.MustCompile(`failed to decode JWT`),
+			},
+		},
+	})
+}
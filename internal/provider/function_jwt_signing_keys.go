@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ function.Function = &jwtSigningKeysFunction{}
+
+func NewJWTSigningKeysFunction() function.Function {
+	return &jwtSigningKeysFunction{}
+}
+
+type jwtSigningKeysFunction struct{}
+
+func (f *jwtSigningKeysFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jwt_signing_keys"
+}
+
+func (f *jwtSigningKeysFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Lists the signing keys declared in a NATS operator or account JWT.",
+		Description: "Returns the sorted signing key public keys of an operator or account JWT, including scoped account signing keys. Other JWT types have no signing keys and return an empty list.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "jwt",
+				Description: "NATS JWT.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *jwtSigningKeysFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var jwtStr string
+	resp.Error = req.Arguments.GetArgument(ctx, 0, &jwtStr)
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := natsjwt.Decode(jwtStr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("failed to decode JWT: %s", err))
+		return
+	}
+
+	keys := []string{}
+	switch c := claims.(type) {
+	case *natsjwt.OperatorClaims:
+		keys = append(keys, c.SigningKeys...)
+	case *natsjwt.AccountClaims:
+		keys = append(keys, c.SigningKeys.Keys()...)
+	}
+	sort.Strings(keys)
+
+	resp.Error = resp.Result.Set(ctx, keys)
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccJWTSigningKeysFunction_Account(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+	_, sk1 := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, sk2 := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	expected := []string{sk1, sk2}
+	sort.Strings(expected)
+
+	config := fmt.Sprintf(`
+data "natsjwt_account" "test" {
+  name          = "app"
+  seed          = %q
+  operator_seed = %q
+  signing_keys  = [%q, %q]
+}
+
+data "natsjwt_user" "test" {
+  name         = "user"
+  seed         = %q
+  account_seed = %q
+}
+
+output "account_keys" {
+  value = join(",", provider::natsjwt::jwt_signing_keys(data.natsjwt_account.test.jwt))
+}
+
+output "user_keys" {
+  value = length(provider::natsjwt::jwt_signing_keys(data.natsjwt_user.test.jwt))
+}
+`, acctSeed, opSeed, sk1, sk2, testUserSeed(t), acctSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("account_keys", strings.Join(expected, ",")),
+					resource.TestCheckOutput("user_keys", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJWTSigningKeysFunction_Operator(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	_, sigPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "test" {
+  name         = "op"
+  seed         = %q
+  signing_keys = [%q]
+}
+
+output "operator_keys" {
+  value = join(",", provider::natsjwt::jwt_signing_keys(data.natsjwt_operator.test.jwt))
+}
+`, opSeed, sigPub)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckOutput("operator_keys", sigPub),
+			},
+		},
+	})
+}
+
+func TestAccJWTSigningKeysFunction_InvalidJWT(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "keys" {
+  value = provider::natsjwt::jwt_signing_keys("not-a-jwt")
+}
+`,
+				ExpectError: regexp.MustCompile(`failed to decode JWT`),
+			},
+		},
+	})
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &permissionDiffFunction{}
+
+func NewPermissionDiffFunction() function.Function {
+	return &permissionDiffFunction{}
+}
+
+type permissionDiffFunction struct{}
+
+func (f *permissionDiffFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "permission_diff"
+}
+
+func (f *permissionDiffFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the subjects of one permission list that are not in another.",
+		Description: "Computes a minus b for diffing roles. Subjects are compared as exact strings, not by wildcard matching. The result keeps the order of a and drops duplicates.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "a",
+				ElementType: types.StringType,
+				Description: "Subjects to keep unless they are in b.",
+			},
+			function.ListParameter{
+				Name:        "b",
+				ElementType: types.StringType,
+				Description: "Subjects to remove from a.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *permissionDiffFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b []string
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, invertSubjects(b, a))
+}
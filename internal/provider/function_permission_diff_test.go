@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPermissionDiffFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "diff" {
+  value = join(",", provider::natsjwt::permission_diff(["a.>", "b.>"], ["a.>"]))
+}
+
+output "reverse" {
+  value = length(provider::natsjwt::permission_diff(["a.>"], ["a.>", "b.>"]))
+}
+
+output "not_wildcard_aware" {
+  value = join(",", provider::natsjwt::permission_diff(["a.b"], ["a.>"]))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("diff", "b.>"),
+					resource.TestCheckOutput("reverse", "0"),
+					resource.TestCheckOutput("not_wildcard_aware", "a.b"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &serverConfigFunction{}
+
+func NewServerConfigFunction() function.Function {
+	return &serverConfigFunction{}
+}
+
+type serverConfigFunction struct{}
+
+func (f *serverConfigFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "server_config"
+}
+
+func (f *serverConfigFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Assembles a NATS server config snippet from operator and account JWTs.",
+		Description: "Produces the same server_config as the natsjwt_config_helper data source with enable_jetstream and listener settings unset, including the jetstream {} block when any account uses JetStream. Pass an empty string to omit the system account or to use the default MEMORY resolver.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "operator_jwt",
+				Description: "The operator JWT.",
+			},
+			function.StringParameter{
+				Name:        "system_account_jwt",
+				Description: "The system account JWT, or an empty string for none.",
+			},
+			function.ListParameter{
+				Name:        "account_jwts",
+				ElementType: types.StringType,
+				Description: "Account JWTs to include in the resolver preload.",
+			},
+			function.StringParameter{
+				Name:        "resolver",
+				Description: "Resolver type. Currently only MEMORY is supported; an empty string defaults to MEMORY.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *serverConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var operatorJWT, systemAccountJWT, resolver string
+	var accountJWTs []string
+	resp.Error = req.Arguments.Get(ctx, &operatorJWT, &systemAccountJWT, &accountJWTs, &resolver)
+	if resp.Error != nil {
+		return
+	}
+
+	cfg, diags := buildServerConfig(operatorJWT, systemAccountJWT, accountJWTs, resolver)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	config := cfg.Config
+	if len(cfg.JetStreamAccounts) > 0 {
+		config = jetStreamBlock + config
+	}
+	resp.Error = resp.Result.Set(ctx, config)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerConfigFunction_MatchesConfigHelper(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	sysSeed := testAccountSeed(t)
+	acct1Seed := testAccountSeed(t)
+	acct2Seed := testAccountSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_system_account" "sys" {
+  name          = "SYS"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_operator" "test" {
+  name           = "op"
+  seed           = %q
+  system_account = data.natsjwt_system_account.sys.public_key
+}
+
+data "natsjwt_account" "one" {
+  name          = "one"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_account" "two" {
+  name          = "two"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_config_helper" "test" {
+  operator_jwt       = data.natsjwt_operator.test.jwt
+  system_account_jwt = data.natsjwt_system_account.sys.jwt
+  account_jwts       = [data.natsjwt_account.one.jwt, data.natsjwt_account.two.jwt]
+}
+
+locals {
+  from_function = provider::natsjwt::server_config(
+    data.natsjwt_operator.test.jwt,
+    data.natsjwt_system_account.sys.jwt,
+    [data.natsjwt_account.two.jwt, data.natsjwt_account.one.jwt],
+    "MEMORY",
+  )
+}
+
+output "matches" {
+  value = local.from_function == data.natsjwt_config_helper.test.server_config
+}
+`, sysSeed, opSeed, opSeed, acct1Seed, opSeed, acct2Seed, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckOutput("matches", "true"),
+			},
+		},
+	})
+}
+
+func TestAccServerConfigFunction_MatchesConfigHelperJetStream(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "test" {
+  name = "op"
+  seed = %[1]q
+}
+
+data "natsjwt_account" "js" {
+  name          = "js"
+  seed          = %[2]q
+  operator_seed = %[1]q
+  jetstream_limits = [{
+    disk_storage = 1073741824
+  }]
+}
+
+data "natsjwt_config_helper" "test" {
+  operator_jwt = data.natsjwt_operator.test.jwt
+  account_jwts = [data.natsjwt_account.js.jwt]
+}
+
+locals {
+  from_function = provider::natsjwt::server_config(data.natsjwt_operator.test.jwt, "", [data.natsjwt_account.js.jwt], "")
+}
+
+output "matches" {
+  value = local.from_function == data.natsjwt_config_helper.test.server_config
+}
+
+output "jetstream" {
+  value = strcontains(local.from_function, "jetstream {}")
+}
+`, opSeed, testAccountSeed(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("matches", "true"),
+					resource.TestCheckOutput("jetstream", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServerConfigFunction_UnsupportedResolver(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+
+	config := fmt.Sprintf(`
+data "natsjwt_operator" "test" {
+  name = "op"
+  seed = %q
+}
+
+output "config" {
+  value = provider::natsjwt::server_config(data.natsjwt_operator.test.jwt, "", [], "FULL")
+}
+`, opSeed)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Only MEMORY resolver is currently supported`),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ function.Function = &userAccountFunction{}
+
+func NewUserAccountFunction() function.Function {
+	return &userAccountFunction{}
+}
+
+type userAccountFunction struct{}
+
+func (f *userAccountFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "user_account"
+}
+
+func (f *userAccountFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the public key of the account a NATS user JWT authenticates against.",
+		Description: "Returns issuer_account when the user was signed with an account signing key, otherwise the issuer.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "jwt",
+				Description: "NATS user JWT.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *userAccountFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var jwtStr string
+	resp.Error = req.Arguments.GetArgument(ctx, 0, &jwtStr)
+	if resp.Error != nil {
+		return
+	}
+
+	claims, err := natsjwt.DecodeUserClaims(jwtStr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("failed to decode user JWT: %s", err))
+		return
+	}
+
+	account := claims.Issuer
+	if claims.IssuerAccount != "" {
+		account = claims.IssuerAccount
+	}
+
+	resp.Error = resp.Result.Set(ctx, account)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccUserAccountFunction_DirectlySigned(t *testing.T) {
+	acctSeed, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	userSeed := testUserSeed(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name         = "direct-user"
+  seed         = %q
+  account_seed = %q
+}
+
+output "account" {
+  value = provider::natsjwt::user_account(data.natsjwt_user.test.jwt)
+}
+`, userSeed, acctSeed),
+				Check: resource.TestCheckOutput("account", acctPub),
+			},
+		},
+	})
+}
+
+func TestAccUserAccountFunction_SigningKey(t *testing.T) {
+	_, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	signingSeed, _ := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	userSeed := testUserSeed(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_user" "test" {
+  name           = "signed-user"
+  seed           = %q
+  account_seed   = %q
+  issuer_account = %q
+}
+
+output "account" {
+  value = provider::natsjwt::user_account(data.natsjwt_user.test.jwt)
+}
+`, userSeed, signingSeed, acctPub),
+				Check: resource.TestCheckOutput("account", acctPub),
+			},
+		},
+	})
+}
+
+func TestAccUserAccountFunction_InvalidJWT(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "account" {
+  value = provider::natsjwt::user_account("not-a-jwt")
+}
+`,
+				ExpectError: regexp.MustCompile(`failed to decode user JWT`),
+			},
+		},
+	})
+}
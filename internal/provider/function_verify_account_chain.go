@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	natsjwt "github.com/nats-io/jwt/v2"
+)
+
+var _ function.Function = &verifyAccountChainFunction{}
+
+func NewVerifyAccountChainFunction() function.Function {
+	return &verifyAccountChainFunction{}
+}
+
+type verifyAccountChainFunction struct{}
+
+func (f *verifyAccountChainFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "verify_account_chain"
+}
+
+func (f *verifyAccountChainFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks that an account JWT was issued by an operator.",
+		Description: "Returns true when the account JWT was signed by the operator identity key or one of the signing keys listed in the operator JWT.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "operator_jwt",
+				Description: "NATS operator JWT.",
+			},
+			function.StringParameter{
+				Name:        "account_jwt",
+				Description: "NATS account JWT.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *verifyAccountChainFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var operatorJWT, accountJWT string
+	resp.Error = req.Arguments.Get(ctx, &operatorJWT, &accountJWT)
+	if resp.Error != nil {
+		return
+	}
+
+	opClaims, err := natsjwt.DecodeOperatorClaims(operatorJWT)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("failed to decode operator JWT: %s", err))
+		return
+	}
+
+	// Decoding also verifies the account JWT signature against its issuer.
+	acctClaims, err := natsjwt.DecodeAccountClaims(accountJWT)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("failed to decode account JWT: %s", err))
+		return
+	}
+
+	trusted := acctClaims.Issuer == opClaims.Subject || opClaims.SigningKeys.Contains(acctClaims.Issuer)
+	resp.Error = resp.Result.Set(ctx, trusted)
+}
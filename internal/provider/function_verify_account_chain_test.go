@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nats-io/nkeys"
+)
+
+func TestAccVerifyAccountChainFunction_SigningKey(t *testing.T) {
+	opSeed := testOperatorSeed(t)
+	signingSeed, signingPub := testSeedAndPublicKey(t, nkeys.PrefixByteOperator)
+	otherSeed := testOperatorSeed(t)
+	acctSeed := testAccountSeed(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "natsjwt_operator" "op" {
+  name         = "chain-op"
+  seed         = %q
+  signing_keys = [%q]
+}
+
+data "natsjwt_account" "signed" {
+  name          = "signed"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_account" "root" {
+  name          = "root"
+  seed          = %q
+  operator_seed = %q
+}
+
+data "natsjwt_account" "foreign" {
+  name          = "foreign"
+  seed          = %q
+  operator_seed = %q
+}
+
+output "signed" {
+  value = provider::natsjwt::verify_account_chain(data.natsjwt_operator.op.jwt, data.natsjwt_account.signed.jwt)
+}
+
+output "root" {
+  value = provider::natsjwt::verify_account_chain(data.natsjwt_operator.op.jwt, data.natsjwt_account.root.jwt)
+}
+
+output "foreign" {
+  value = provider::natsjwt::verify_account_chain(data.natsjwt_operator.op.jwt, data.natsjwt_account.foreign.jwt)
+}
+`, opSeed, signingPub, acctSeed, signingSeed, acctSeed, opSeed, acctSeed, otherSeed),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("signed", "true"),
+					resource.TestCheckOutput("root", "true"),
+					resource.TestCheckOutput("foreign", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifyAccountChainFunction_InvalidJWT(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "chain" {
+  value = provider::natsjwt::verify_account_chain("not-a-jwt", "not-a-jwt")
+}
+`,
+				ExpectError: regexp.MustCompile(`failed to decode operator JWT`),
+			},
+		},
+	})
+}
@@ -1,29 +1,61 @@
 package provider
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	natsjwt "github.com/nats-io/jwt/v2"
 	"github.com/nats-io/nkeys"
+	"golang.org/x/crypto/scrypt"
 )
 
 var objectAsOptions = basetypes.ObjectAsOptions{}
 
+// Signer signs JWTs. nkeys.KeyPair satisfies it; other implementations allow
+// keys that never leave external hardware or services.
+type Signer interface {
+	PublicKey() (string, error)
+	Sign(input []byte) ([]byte, error)
+}
+
 // encodeDeterministic encodes claims with stable deterministic fields.
 // The standard jwt library always sets IssuedAt to the current time, so instead
 // we build the JWT manually: adjust the claim fields we care about, perform a
 // trial Encode to trigger internal updates (specifically updateVersion), then
 // marshal the header and payload ourselves and sign the result for a
 // deterministic token.
-func encodeDeterministic(claims natsjwt.Claims, kp nkeys.KeyPair) (string, error) {
+func encodeDeterministic(claims natsjwt.Claims, signer Signer) (string, error) {
+	return encodeDeterministicWithExtra(claims, signer, nil)
+}
+
+// encodeDeterministicWithExtra works like encodeDeterministic but merges extra
+// top-level fields into the payload before signing. Callers validate extra with
+// parseExtraClaims so it cannot override standard fields.
+func encodeDeterministicWithExtra(claims natsjwt.Claims, signer Signer, extra map[string]json.RawMessage) (string, error) {
+	return encodeDeterministicWithOptions(claims, signer, extra, true)
+}
+
+// encodeDeterministicWithOptions works like encodeDeterministicWithExtra. With
+// omitEmpty false the payload is marshalled by marshalKeepZero, so fields the
+// jwt library tags omitempty are written out even when they hold zero values.
+func encodeDeterministicWithOptions(claims natsjwt.Claims, signer Signer, extra map[string]json.RawMessage, omitEmpty bool) (string, error) {
 	// First, do a normal encode to get a valid JWT structure
 	cd := claims.Claims()
 	issuedAt := cd.IssuedAt
-	cd.ID = ""
+	// The library replaces the ID with a hash of the claims; keep the caller's
+	// ID instead, which is empty unless it was set deliberately.
+	id := cd.ID
 
 	// We need to manually construct the JWT with deterministic fields.
 	// Build header
@@ -37,32 +69,59 @@ func encodeDeterministic(claims natsjwt.Claims, kp nkeys.KeyPair) (string, error
 	}
 	headerB64 := base64.RawURLEncoding.EncodeToString(headerJSON)
 
-	// Set issuer from keypair
-	pub, err := kp.PublicKey()
+	// Set issuer from the signer
+	pub, err := signer.PublicKey()
 	if err != nil {
 		return "", fmt.Errorf("failed to get public key: %w", err)
 	}
-	cd.Issuer = pub
 
-	// Ensure updateVersion is called by doing a trial encode first
-	if _, err := claims.Encode(kp); err != nil {
+	// Ensure updateVersion is called by doing a trial encode first. The library
+	// needs a key pair for that, so other signers use a throwaway one of the same type.
+	trialKP, ok := signer.(nkeys.KeyPair)
+	if !ok {
+		trialKP, err = nkeys.CreatePair(nkeys.Prefix(pub))
+		if err != nil {
+			return "", fmt.Errorf("failed to create trial key pair: %w", err)
+		}
+	}
+	if _, err := claims.Encode(trialKP); err != nil {
 		return "", fmt.Errorf("failed to run trial encode: %w", err)
 	}
 
 	// Now reset deterministic fields
+	cd.Issuer = pub
 	cd.IssuedAt = issuedAt
-	cd.ID = ""
+	cd.ID = id
 
 	// Serialize payload
-	payloadJSON, err := json.Marshal(claims)
+	var payloadJSON []byte
+	if omitEmpty {
+		payloadJSON, err = json.Marshal(claims)
+	} else {
+		payloadJSON, err = marshalKeepZero(claims)
+	}
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal claims: %w", err)
 	}
+	if len(extra) > 0 {
+		var payload map[string]json.RawMessage
+		if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+			return "", fmt.Errorf("failed to unmarshal claims: %w", err)
+		}
+		for k, v := range extra {
+			payload[k] = v
+		}
+		// Map keys are marshalled in sorted order, so the result stays deterministic.
+		payloadJSON, err = json.Marshal(payload)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal claims with extra fields: %w", err)
+		}
+	}
 	payloadB64 := base64.RawURLEncoding.EncodeToString(payloadJSON)
 
 	// Sign
 	toSign := headerB64 + "." + payloadB64
-	sig, err := kp.Sign([]byte(toSign))
+	sig, err := signer.Sign([]byte(toSign))
 	if err != nil {
 		return "", fmt.Errorf("failed to sign: %w", err)
 	}
@@ -71,6 +130,188 @@ func encodeDeterministic(claims natsjwt.Claims, kp nkeys.KeyPair) (string, error
 	return toSign + "." + sigB64, nil
 }
 
+// omitEmptyFields reads an omit_empty_fields attribute, which defaults to true.
+func omitEmptyFields(v types.Bool) bool {
+	return v.IsNull() || v.ValueBool()
+}
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// marshalKeepZero marshals v like json.Marshal but ignores omitempty, so zero
+// values and nil slices, maps and pointers are written as 0, false, "" or null.
+// Types with their own MarshalJSON are encoded by it unchanged. Object keys come
+// out sorted rather than in struct order.
+func marshalKeepZero(v any) ([]byte, error) {
+	tree, err := keepZeroValue(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(tree)
+}
+
+// keepZeroValue converts rv into plain maps, slices and scalars for marshalKeepZero.
+func keepZeroValue(rv reflect.Value) (any, error) {
+	if !rv.IsValid() {
+		return nil, nil
+	}
+
+	if m, ok := jsonMarshaler(rv); ok {
+		if (rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface) && rv.IsNil() {
+			return nil, nil
+		}
+		raw, err := json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(raw), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return keepZeroValue(rv.Elem())
+	case reflect.Struct:
+		out := map[string]any{}
+		if err := keepZeroFields(rv, out); err != nil {
+			return nil, err
+		}
+		return out, nil
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		if rv.Type().Key().Kind() != reflect.String {
+			// Leave non-string keys to encoding/json.
+			return rv.Interface(), nil
+		}
+		out := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			value, err := keepZeroValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			out[iter.Key().String()] = value
+		}
+		return out, nil
+	case reflect.Slice:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			// Byte slices marshal as base64 strings.
+			return rv.Interface(), nil
+		}
+		fallthrough
+	case reflect.Array:
+		out := make([]any, rv.Len())
+		for i := range out {
+			value, err := keepZeroValue(rv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			out[i] = value
+		}
+		return out, nil
+	default:
+		return rv.Interface(), nil
+	}
+}
+
+// keepZeroFields adds the JSON fields of struct rv to out. Fields of embedded
+// structs are promoted unless the outer struct already sets the same name.
+func keepZeroFields(rv reflect.Value, out map[string]any) error {
+	var embedded []reflect.Value
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			embedded = append(embedded, rv.Field(i))
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		value, err := keepZeroValue(rv.Field(i))
+		if err != nil {
+			return err
+		}
+		out[name] = value
+	}
+
+	for _, e := range embedded {
+		if e.Kind() == reflect.Pointer {
+			if e.IsNil() {
+				continue
+			}
+			e = e.Elem()
+		}
+		promoted := map[string]any{}
+		if err := keepZeroFields(e, promoted); err != nil {
+			return err
+		}
+		for k, v := range promoted {
+			if _, ok := out[k]; !ok {
+				out[k] = v
+			}
+		}
+	}
+	return nil
+}
+
+// jsonMarshaler returns rv as a json.Marshaler when its type, or a pointer to
+// it, implements MarshalJSON.
+func jsonMarshaler(rv reflect.Value) (json.Marshaler, bool) {
+	if rv.Type().Implements(jsonMarshalerType) {
+		return rv.Interface().(json.Marshaler), true
+	}
+	if reflect.PointerTo(rv.Type()).Implements(jsonMarshalerType) {
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		return ptr.Interface().(json.Marshaler), true
+	}
+	return nil, false
+}
+
+// jwtSignature returns the signature segment of an encoded JWT.
+func jwtSignature(jwtString string) string {
+	return jwtString[strings.LastIndex(jwtString, ".")+1:]
+}
+
+// protectedClaimFields are the payload fields that extra_claims may not set.
+var protectedClaimFields = []string{"aud", "exp", "iat", "iss", "jti", "name", "nats", "nbf", "sub"}
+
+// parseExtraClaims parses an extra_claims JSON object. An empty string yields nil.
+func parseExtraClaims(extra string) (map[string]json.RawMessage, error) {
+	if extra == "" {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(extra), &fields); err != nil {
+		return nil, fmt.Errorf("must be a JSON object: %w", err)
+	}
+	for _, protected := range protectedClaimFields {
+		if _, ok := fields[protected]; ok {
+			return nil, fmt.Errorf("field %q is set by the provider and cannot be overridden", protected)
+		}
+	}
+	return fields, nil
+}
+
 // prefixByteFromType converts a string type name to an nkeys.PrefixByte.
 func prefixByteFromType(keyType string) (nkeys.PrefixByte, error) {
 	switch keyType {
@@ -129,6 +370,76 @@ func buildPermission(allow, deny []string) natsjwt.Permission {
 	return p
 }
 
+// resolveIssuedAt returns issued_at, or issued_at_rfc3339 converted to Unix
+// seconds when that is set instead, rounded down to roundTo. Setting both is an error.
+func resolveIssuedAt(issuedAt types.Int64, issuedAtRFC3339 types.String, roundTo time.Duration, diags *diag.Diagnostics) types.Int64 {
+	if issuedAtRFC3339.IsNull() {
+		return roundIssuedAt(issuedAt, roundTo)
+	}
+	if !issuedAt.IsNull() {
+		diags.AddAttributeError(path.Root("issued_at_rfc3339"), "Conflicting Issued At",
+			"issued_at and issued_at_rfc3339 cannot both be set")
+		return issuedAt
+	}
+	t, err := time.Parse(time.RFC3339, issuedAtRFC3339.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("issued_at_rfc3339"), "Invalid Issued At",
+			fmt.Sprintf("issued_at_rfc3339 must be an RFC 3339 timestamp such as 2023-01-01T00:00:00Z: %s", err))
+		return issuedAt
+	}
+	return roundIssuedAt(types.Int64Value(t.Unix()), roundTo)
+}
+
+// roundIssuedAt rounds issuedAt down to a multiple of roundTo since the Unix
+// epoch. A null issuedAt or a zero roundTo is returned unchanged.
+func roundIssuedAt(issuedAt types.Int64, roundTo time.Duration) types.Int64 {
+	step := int64(roundTo / time.Second)
+	if issuedAt.IsNull() || step <= 0 {
+		return issuedAt
+	}
+	v := issuedAt.ValueInt64()
+	rem := v % step
+	if rem < 0 {
+		rem += step
+	}
+	return types.Int64Value(v - rem)
+}
+
+// checkMinValidity fails when a JWT expiring at expires stays valid for less
+// than min_validity after referenceUnix, which defaults to the current time. A
+// JWT without expiry always passes.
+func checkMinValidity(expires types.Int64, minValidity types.String, referenceUnix types.Int64, diags *diag.Diagnostics) {
+	if minValidity.IsNull() || expires.IsNull() {
+		return
+	}
+	required, err := time.ParseDuration(minValidity.ValueString())
+	if err != nil || required < 0 {
+		diags.AddAttributeError(path.Root("min_validity"), "Invalid Min Validity",
+			fmt.Sprintf("min_validity must be a non-negative Go duration such as 1h, got %q", minValidity.ValueString()))
+		return
+	}
+
+	reference := time.Now().Unix()
+	if !referenceUnix.IsNull() {
+		reference = referenceUnix.ValueInt64()
+	}
+	if remaining := time.Duration(expires.ValueInt64()-reference) * time.Second; remaining < required {
+		diags.AddAttributeError(path.Root("expires"), "Insufficient Validity",
+			fmt.Sprintf("The JWT expires at %d, %s after the reference time %d, which is less than min_validity %s",
+				expires.ValueInt64(), remaining, reference, required))
+	}
+}
+
+// signedBySigningKey reports whether issuer, the key that signed a JWT, differs
+// from root, the identity key the JWT belongs under. An empty root means the
+// identity key is not known and yields null.
+func signedBySigningKey(issuer, root string) types.Bool {
+	if root == "" {
+		return types.BoolNull()
+	}
+	return types.BoolValue(issuer != root)
+}
+
 // applyTemporalClaimsDefaults maps Terraform temporal attributes to JWT claims.
 // Defaults are: IssuedAt=0 (Unix epoch), Expires unset (no expiration),
 // and NotBefore=IssuedAt when not provided explicitly.
@@ -147,3 +458,73 @@ func applyTemporalClaimsDefaults(cd *natsjwt.ClaimsData, issuedAt, expires, notB
 		cd.NotBefore = cd.IssuedAt
 	}
 }
+
+// Parameters for deriving the seed encryption key from the passphrase with
+// scrypt. Each encrypted value carries its own random salt.
+const (
+	seedSaltSize = 16
+	seedScryptN  = 1 << 15
+	seedScryptR  = 8
+	seedScryptP  = 1
+)
+
+// newSeedCipher derives an AES-256-GCM cipher from the passphrase and salt.
+func newSeedCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, seedScryptN, seedScryptR, seedScryptP, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return gcm, nil
+}
+
+// encryptSeed encrypts a seed with AES-GCM under a scrypt-derived key and
+// returns base64(salt || nonce || ciphertext).
+func encryptSeed(passphrase, seed string) (string, error) {
+	salt := make([]byte, seedSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	gcm, err := newSeedCipher(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := gcm.Seal(append(salt, nonce...), nonce, []byte(seed), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSeed reverses encryptSeed.
+func decryptSeed(passphrase, encrypted string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode encrypted seed: %w", err)
+	}
+	if len(sealed) < seedSaltSize {
+		return "", fmt.Errorf("encrypted seed is too short")
+	}
+	salt, sealed := sealed[:seedSaltSize], sealed[seedSaltSize:]
+	gcm, err := newSeedCipher(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("encrypted seed is too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	seed, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt seed: %w", err)
+	}
+	return string(seed), nil
+}
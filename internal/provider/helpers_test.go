@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+)
+
+// recordingSigner signs with a wrapped key pair without exposing it as an
+// nkeys.KeyPair, and records what it was asked to sign.
+type recordingSigner struct {
+	kp     nkeys.KeyPair
+	signed [][]byte
+}
+
+func (s *recordingSigner) PublicKey() (string, error) {
+	return s.kp.PublicKey()
+}
+
+func (s *recordingSigner) Sign(input []byte) ([]byte, error) {
+	s.signed = append(s.signed, append([]byte(nil), input...))
+	return s.kp.Sign(input)
+}
+
+func TestEncodeDeterministic_Signer(t *testing.T) {
+	opKP, err := nkeys.CreatePair(nkeys.PrefixByteOperator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opPub, err := opKP.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	acctKP, err := nkeys.CreatePair(nkeys.PrefixByteAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acctPub, err := acctKP.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := natsjwt.NewAccountClaims(acctPub)
+	claims.Name = "signer-acct"
+	signer := &recordingSigner{kp: opKP}
+
+	token, err := encodeDeterministic(claims, signer)
+	if err != nil {
+		t.Fatalf("encodeDeterministic: %s", err)
+	}
+
+	if len(signer.signed) != 1 {
+		t.Fatalf("expected exactly one signing call, got %d", len(signer.signed))
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("expected a three part JWT, got %q", token)
+	}
+	if got, want := string(signer.signed[0]), parts[0]+"."+parts[1]; got != want {
+		t.Fatalf("signer received %q, want header.payload %q", got, want)
+	}
+
+	decoded, err := natsjwt.DecodeAccountClaims(token)
+	if err != nil {
+		t.Fatalf("signature does not verify: %s", err)
+	}
+	if decoded.Issuer != opPub {
+		t.Fatalf("expected issuer %s, got %s", opPub, decoded.Issuer)
+	}
+
+	// The same claims signed directly with the key pair must give the same token.
+	direct, err := encodeDeterministic(claims, opKP)
+	if err != nil {
+		t.Fatalf("encodeDeterministic with key pair: %s", err)
+	}
+	if direct != token {
+		t.Fatalf("external signer changed the encoded JWT")
+	}
+}
+
+func TestRoundIssuedAt(t *testing.T) {
+	tests := map[string]struct {
+		issuedAt types.Int64
+		roundTo  time.Duration
+		want     types.Int64
+	}{
+		"hour":        {issuedAt: types.Int64Value(3661), roundTo: time.Hour, want: types.Int64Value(3600)},
+		"aligned":     {issuedAt: types.Int64Value(7200), roundTo: time.Hour, want: types.Int64Value(7200)},
+		"day":         {issuedAt: types.Int64Value(1700000000), roundTo: 24 * time.Hour, want: types.Int64Value(1699920000)},
+		"before 1970": {issuedAt: types.Int64Value(-1), roundTo: time.Hour, want: types.Int64Value(-3600)},
+		"no rounding": {issuedAt: types.Int64Value(3661), roundTo: 0, want: types.Int64Value(3661)},
+		"null":        {issuedAt: types.Int64Null(), roundTo: time.Hour, want: types.Int64Null()},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := roundIssuedAt(tc.issuedAt, tc.roundTo); !got.Equal(tc.want) {
+				t.Fatalf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCheckMinValidity(t *testing.T) {
+	tests := map[string]struct {
+		expires     types.Int64
+		minValidity types.String
+		wantErr     bool
+	}{
+		"long enough":      {expires: types.Int64Value(1700007200), minValidity: types.StringValue("1h")},
+		"exactly enough":   {expires: types.Int64Value(1700003600), minValidity: types.StringValue("1h")},
+		"too short":        {expires: types.Int64Value(1700000300), minValidity: types.StringValue("1h"), wantErr: true},
+		"already expired":  {expires: types.Int64Value(1600000000), minValidity: types.StringValue("0s"), wantErr: true},
+		"no expiry":        {expires: types.Int64Null(), minValidity: types.StringValue("1h")},
+		"no min_validity":  {expires: types.Int64Value(1700000300), minValidity: types.StringNull()},
+		"invalid duration": {expires: types.Int64Value(1700007200), minValidity: types.StringValue("soon"), wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkMinValidity(tc.expires, tc.minValidity, types.Int64Value(1700000000), &diags)
+			if got := diags.HasError(); got != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestMarshalKeepZero(t *testing.T) {
+	_, acctPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	claims := natsjwt.NewAccountClaims(acctPub)
+	claims.Name = "keep-zero"
+	claims.Exports.Add(&natsjwt.Export{Name: "svc", Subject: "svc.>", Type: natsjwt.Service})
+
+	payload, err := marshalKeepZero(claims)
+	if err != nil {
+		t.Fatalf("marshalKeepZero: %v", err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	for _, name := range []string{"exp", "nbf", "jti"} {
+		if _, ok := fields[name]; !ok {
+			t.Errorf("expected zero-valued %q in payload %s", name, payload)
+		}
+	}
+	exports := fields["nats"].(map[string]interface{})["exports"].([]interface{})
+	if got := exports[0].(map[string]interface{})["type"]; got != "service" {
+		t.Errorf("expected export type to use its MarshalJSON, got %v", got)
+	}
+
+	var decoded natsjwt.AccountClaims
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		t.Fatalf("payload does not unmarshal into account claims: %v", err)
+	}
+	if decoded.Name != claims.Name || decoded.Limits.NatsLimits != claims.Limits.NatsLimits || decoded.Limits.AccountLimits != claims.Limits.AccountLimits || len(decoded.Exports) != 1 {
+		t.Errorf("round trip mismatch: got %+v", decoded)
+	}
+}
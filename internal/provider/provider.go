@@ -2,21 +2,80 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ provider.Provider = &NatsjwtProvider{}
 var _ provider.ProviderWithFunctions = &NatsjwtProvider{}
+var _ provider.ProviderWithEphemeralResources = &NatsjwtProvider{}
 
 type NatsjwtProvider struct {
 	version string
 }
 
+type NatsjwtProviderModel struct {
+	StateEncryptionKey types.String `tfsdk:"state_encryption_key"`
+	MaxJWTSize         types.Int64  `tfsdk:"max_jwt_size"`
+	IssuedAtRoundTo    types.String `tfsdk:"issued_at_round_to"`
+}
+
+// NatsjwtProviderData is the provider-level configuration passed to resources and data sources.
+type NatsjwtProviderData struct {
+	StateEncryptionKey string
+	MaxJWTSize         int64
+	IssuedAtRoundTo    time.Duration
+}
+
+// defaultMaxJWTSize is nats-server's default max_payload. Larger account JWTs cannot be
+// pushed to a resolver and larger user JWTs are rejected on connect.
+const defaultMaxJWTSize = 1024 * 1024
+
+// jwtSettings is embedded by data sources that sign JWTs to pick up the provider's
+// max_jwt_size and issued_at_round_to settings.
+type jwtSettings struct {
+	maxJWTSize      int64
+	issuedAtRoundTo time.Duration
+}
+
+func (l *jwtSettings) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*NatsjwtProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("Expected *NatsjwtProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	l.maxJWTSize = data.MaxJWTSize
+	l.issuedAtRoundTo = data.IssuedAtRoundTo
+}
+
+// checkJWTSize adds an error to diags when the encoded JWT exceeds the configured limit.
+func (l *jwtSettings) checkJWTSize(kind, jwtString string, diags *diag.Diagnostics) {
+	limit := l.maxJWTSize
+	if limit <= 0 {
+		limit = defaultMaxJWTSize
+	}
+	if int64(len(jwtString)) > limit {
+		diags.AddError("JWT Too Large",
+			fmt.Sprintf("The encoded %s JWT is %d bytes, which exceeds max_jwt_size of %d bytes", kind, len(jwtString), limit))
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &NatsjwtProvider{
@@ -33,10 +92,51 @@ func (p *NatsjwtProvider) Metadata(_ context.Context, _ provider.MetadataRequest
 func (p *NatsjwtProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage NATS JWT credentials offline without a running NATS server.",
+		Attributes: map[string]schema.Attribute{
+			"state_encryption_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Passphrase used to encrypt NKey seeds (AES-GCM) into the seed_encrypted attribute of natsjwt_nkey resources.",
+			},
+			"max_jwt_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum size in bytes of a generated JWT. Data sources fail when a JWT would exceed it. Defaults to 1048576, the default NATS max_payload.",
+			},
+			"issued_at_round_to": schema.StringAttribute{
+				Optional:    true,
+				Description: "Go duration, e.g. 1h, that configured issued_at values are rounded down to before signing, so wall-clock-derived timestamps do not change the JWT on every run. Must be a whole number of seconds. Defaults to no rounding.",
+			},
+		},
 	}
 }
 
-func (p *NatsjwtProvider) Configure(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
+func (p *NatsjwtProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config NatsjwtProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := &NatsjwtProviderData{MaxJWTSize: defaultMaxJWTSize}
+	if !config.StateEncryptionKey.IsNull() {
+		data.StateEncryptionKey = config.StateEncryptionKey.ValueString()
+	}
+	if !config.MaxJWTSize.IsNull() {
+		data.MaxJWTSize = config.MaxJWTSize.ValueInt64()
+	}
+	if !config.IssuedAtRoundTo.IsNull() {
+		roundTo, err := time.ParseDuration(config.IssuedAtRoundTo.ValueString())
+		if err != nil || roundTo < time.Second || roundTo%time.Second != 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("issued_at_round_to"), "Invalid Issued At Rounding",
+				fmt.Sprintf("issued_at_round_to must be a duration of whole seconds, at least 1s, such as 1h. Got: %q", config.IssuedAtRoundTo.ValueString()))
+			return
+		}
+		data.IssuedAtRoundTo = roundTo
+	}
+
+	resp.ResourceData = data
+	resp.DataSourceData = data
+	resp.EphemeralResourceData = data
 }
 
 func (p *NatsjwtProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -52,11 +152,47 @@ func (p *NatsjwtProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewSystemAccountDataSource,
 		NewUserDataSource,
 		NewConfigHelperDataSource,
+		NewMemoryResolverDataSource,
+		NewEffectivePermissionsDataSource,
+		NewCredsInfoDataSource,
+		NewCredsFileDataSource,
+		NewEnvSeedDataSource,
+		NewDecodedOperatorDataSource,
+		NewPruneRevocationsDataSource,
+		NewScopeCheckDataSource,
+		NewPermissionSetDataSource,
+		NewNkeyDataSource,
+		NewAccountCredsDataSource,
+		NewLeafnodeAccountDataSource,
+		NewRotationPlanDataSource,
+		NewNscImportDataSource,
+	}
+}
+
+func (p *NatsjwtProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewUserTokenEphemeralResource,
+		NewNkeySeedEphemeralResource,
 	}
 }
 
 func (p *NatsjwtProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewSeedPublicKeyFunction,
+		NewJWTExportsFunction,
+		NewServerConfigFunction,
+		NewUserAccountFunction,
+		NewCredsPathFunction,
+		NewJWTPrettyFunction,
+		NewExpiryAtFunction,
+		NewVerifyAccountChainFunction,
+		NewConfigAccountsFunction,
+		NewJWTJetStreamTiersFunction,
+		NewJWTFingerprintFunction,
+		NewInvertPermissionFunction,
+		NewJWTSigningKeysFunction,
+		NewPermissionDiffFunction,
+		NewAccountSchemaJSONFunction,
+		NewJetStreamTierForFunction,
 	}
 }
@@ -2,26 +2,42 @@ package provider
 
 import (
 	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
 	"github.com/nats-io/nkeys"
 )
 
 var _ resource.Resource = &NkeyResource{}
+var _ resource.ResourceWithConfigure = &NkeyResource{}
 
-type NkeyResource struct{}
+type NkeyResource struct {
+	encryptionKey string
+}
 
 type NkeyResourceModel struct {
-	Keepers   types.Map    `tfsdk:"keepers"`
-	Type      types.String `tfsdk:"type"`
-	Seed      types.String `tfsdk:"seed"`
-	PublicKey types.String `tfsdk:"public_key"`
+	Keepers              types.Map    `tfsdk:"keepers"`
+	Type                 types.String `tfsdk:"type"`
+	Seed                 types.String `tfsdk:"seed"`
+	SeedEncrypted        types.String `tfsdk:"seed_encrypted"`
+	SeedDecorated        types.String `tfsdk:"seed_decorated"`
+	PublicKey            types.String `tfsdk:"public_key"`
+	SeedBase64           types.String `tfsdk:"seed_base64"`
+	PublicKeyHex         types.String `tfsdk:"public_key_bytes_hex"`
+	WithSigningKey       types.Bool   `tfsdk:"with_signing_key"`
+	SigningSeed          types.String `tfsdk:"signing_seed"`
+	SigningSeedEncrypted types.String `tfsdk:"signing_seed_encrypted"`
+	SigningPublicKey     types.String `tfsdk:"signing_public_key"`
 }
 
 func NewNkeyResource() resource.Resource {
@@ -32,6 +48,21 @@ func (r *NkeyResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_nkey"
 }
 
+func (r *NkeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*NatsjwtProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data",
+			fmt.Sprintf("Expected *NatsjwtProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.encryptionKey = data.StateEncryptionKey
+}
+
 func (r *NkeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Generates an NKey pair (seed + public key) for NATS authentication.",
@@ -55,7 +86,22 @@ func (r *NkeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"seed": schema.StringAttribute{
 				Computed:    true,
 				Sensitive:   true,
-				Description: "The generated NKey seed (private key). Starts with SO (operator), SA (account), or SU (user).",
+				Description: "The generated NKey seed (private key). Starts with SO (operator), SA (account), or SU (user). Null when the provider's state_encryption_key is set; the seed is then only kept in seed_encrypted.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"seed_encrypted": schema.StringAttribute{
+				Computed:    true,
+				Description: "The seed encrypted with the provider's state_encryption_key (AES-256-GCM under a scrypt-derived key, base64 encoded). Null when no key is configured.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"seed_decorated": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The seed in decorated form (-----BEGIN ... NKEY SEED-----), as used in NATS creds files. Null when the provider's state_encryption_key is set.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -67,6 +113,50 @@ func (r *NkeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"seed_base64": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The raw 32-byte ed25519 seed, standard base64 encoded, for tools that take key material instead of NKey strings. Null when the provider's state_encryption_key is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key_bytes_hex": schema.StringAttribute{
+				Computed:    true,
+				Description: "The raw 32-byte ed25519 public key, hex encoded.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"with_signing_key": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Also generate a signing key pair of the same type. Only valid for operator and account keys.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"signing_seed": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Seed of the paired signing key. Null unless with_signing_key is true, and null when the provider's state_encryption_key is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signing_seed_encrypted": schema.StringAttribute{
+				Computed:    true,
+				Description: "Seed of the paired signing key, encrypted like seed_encrypted. Null unless with_signing_key is true and state_encryption_key is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signing_public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key of the paired signing key. Null unless with_signing_key is true.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -84,6 +174,11 @@ func (r *NkeyResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	if data.WithSigningKey.ValueBool() && prefixByte == nkeys.PrefixByteUser {
+		resp.Diagnostics.AddError("Invalid Key Type", "with_signing_key is only supported for operator and account keys")
+		return
+	}
+
 	kp, err := nkeys.CreatePair(prefixByte)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to Create NKey", fmt.Sprintf("Could not create NKey pair: %s", err))
@@ -102,8 +197,70 @@ func (r *NkeyResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	decorated, err := natsjwt.DecorateSeed(seed)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Decorate Seed", fmt.Sprintf("Could not decorate seed: %s", err))
+		return
+	}
+
+	seedBase64, publicKeyHex, err := nkeyRawMaterial(string(seed))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Decode Seed", fmt.Sprintf("Could not decode seed: %s", err))
+		return
+	}
+
 	data.Seed = types.StringValue(string(seed))
+	data.SeedDecorated = types.StringValue(string(decorated))
 	data.PublicKey = types.StringValue(pub)
+	data.SeedBase64 = types.StringValue(seedBase64)
+	data.PublicKeyHex = types.StringValue(publicKeyHex)
+	data.SeedEncrypted = types.StringNull()
+	data.SigningSeed = types.StringNull()
+	data.SigningSeedEncrypted = types.StringNull()
+	data.SigningPublicKey = types.StringNull()
+
+	if data.WithSigningKey.ValueBool() {
+		skp, err := nkeys.CreatePair(prefixByte)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Create NKey", fmt.Sprintf("Could not create signing key pair: %s", err))
+			return
+		}
+		signingSeed, err := skp.Seed()
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Get Seed", fmt.Sprintf("Could not get seed from signing keypair: %s", err))
+			return
+		}
+		signingPub, err := skp.PublicKey()
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Get Public Key", fmt.Sprintf("Could not get public key from signing keypair: %s", err))
+			return
+		}
+		data.SigningSeed = types.StringValue(string(signingSeed))
+		data.SigningPublicKey = types.StringValue(signingPub)
+	}
+
+	// With an encryption key only the encrypted seeds are written to state
+	if r.encryptionKey != "" {
+		encrypted, err := encryptSeed(r.encryptionKey, string(seed))
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Encrypt Seed", fmt.Sprintf("Could not encrypt seed: %s", err))
+			return
+		}
+		data.SeedEncrypted = types.StringValue(encrypted)
+		data.Seed = types.StringNull()
+		data.SeedDecorated = types.StringNull()
+		data.SeedBase64 = types.StringNull()
+
+		if !data.SigningSeed.IsNull() {
+			encrypted, err := encryptSeed(r.encryptionKey, data.SigningSeed.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to Encrypt Seed", fmt.Sprintf("Could not encrypt signing seed: %s", err))
+				return
+			}
+			data.SigningSeedEncrypted = types.StringValue(encrypted)
+			data.SigningSeed = types.StringNull()
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -115,8 +272,27 @@ func (r *NkeyResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Re-derive public key from seed to verify consistency
-	kp, err := nkeys.FromSeed([]byte(data.Seed.ValueString()))
+	seed := data.Seed.ValueString()
+	if data.Seed.IsNull() && !data.SeedEncrypted.IsNull() {
+		// The seed is only stored encrypted. Decrypt it to check the public key,
+		// but never write the plaintext back to state.
+		if r.encryptionKey == "" {
+			resp.Diagnostics.AddWarning("Seed Not Verified",
+				"seed_encrypted is set but the provider has no state_encryption_key, so the public key could not be checked against the seed.")
+			return
+		}
+		decrypted, err := decryptSeed(r.encryptionKey, data.SeedEncrypted.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Decrypt Seed",
+				fmt.Sprintf("Could not decrypt seed_encrypted with the configured state_encryption_key: %s", err))
+			return
+		}
+		seed = decrypted
+	}
+
+	// Re-derive public key from seed to verify consistency. Only a seed that
+	// cannot be parsed drops the resource; the seed is the key itself.
+	kp, err := nkeys.FromSeed([]byte(seed))
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -128,7 +304,32 @@ func (r *NkeyResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	if !data.PublicKey.IsNull() && data.PublicKey.ValueString() != pub {
+		resp.Diagnostics.AddWarning("Public Key Repaired",
+			fmt.Sprintf("The public_key in state (%s) does not match the seed; it has been replaced with %s.",
+				data.PublicKey.ValueString(), pub))
+	}
+
+	decorated, err := natsjwt.DecorateSeed([]byte(seed))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Decorate Seed", fmt.Sprintf("Could not decorate seed: %s", err))
+		return
+	}
+
+	seedBase64, publicKeyHex, err := nkeyRawMaterial(seed)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Decode Seed", fmt.Sprintf("Could not decode seed: %s", err))
+		return
+	}
+
 	data.PublicKey = types.StringValue(pub)
+	data.PublicKeyHex = types.StringValue(publicKeyHex)
+	// Other encodings of the seed are only kept when the seed itself is in
+	// state, so an encrypted seed is not leaked through them.
+	if !data.Seed.IsNull() {
+		data.SeedDecorated = types.StringValue(string(decorated))
+		data.SeedBase64 = types.StringValue(seedBase64)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -147,7 +348,14 @@ func (r *NkeyResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	data.Seed = state.Seed
+	data.SeedEncrypted = state.SeedEncrypted
+	data.SeedDecorated = state.SeedDecorated
 	data.PublicKey = state.PublicKey
+	data.SeedBase64 = state.SeedBase64
+	data.PublicKeyHex = state.PublicKeyHex
+	data.SigningSeed = state.SigningSeed
+	data.SigningSeedEncrypted = state.SigningSeedEncrypted
+	data.SigningPublicKey = state.SigningPublicKey
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -156,6 +364,20 @@ func (r *NkeyResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *re
 	// No-op: state removal is handled by the framework
 }
 
+// nkeyRawMaterial returns the raw ed25519 seed of an NKey seed as standard base64
+// and the matching raw public key as hex.
+func nkeyRawMaterial(seed string) (string, string, error) {
+	_, raw, err := nkeys.DecodeSeed([]byte(seed))
+	if err != nil {
+		return "", "", err
+	}
+	if len(raw) != ed25519.SeedSize {
+		return "", "", fmt.Errorf("expected a %d-byte seed, got %d bytes", ed25519.SeedSize, len(raw))
+	}
+	pub := ed25519.NewKeyFromSeed(raw).Public().(ed25519.PublicKey)
+	return base64.StdEncoding.EncodeToString(raw), hex.EncodeToString(pub), nil
+}
+
 // requiresReplaceIfValuesNotNull triggers replacement when keeper values change from non-null.
 type requiresReplaceIfValuesNotNull struct{}
 
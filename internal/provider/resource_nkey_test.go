@@ -1,12 +1,23 @@
 package provider
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	natsjwt "github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
 )
 
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
@@ -97,3 +108,304 @@ resource "natsjwt_nkey" "test" {
 		},
 	})
 }
+
+func TestAccNkeyResource_SeedEncryption(t *testing.T) {
+	const encryptionKey = "correct horse battery staple"
+	config := fmt.Sprintf(`
+provider "natsjwt" {
+  state_encryption_key = %q
+}
+
+resource "natsjwt_nkey" "test" {
+  type             = "account"
+  with_signing_key = true
+}`, encryptionKey)
+
+	// checkEncrypted decrypts both seeds, checks them against the public keys
+	// and verifies that neither plaintext seed appears anywhere in state.
+	checkEncrypted := func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["natsjwt_nkey.test"]
+		if !ok {
+			return fmt.Errorf("not found")
+		}
+		attrs := rs.Primary.Attributes
+		for _, pair := range []struct{ encrypted, public string }{
+			{"seed_encrypted", "public_key"},
+			{"signing_seed_encrypted", "signing_public_key"},
+		} {
+			seed, err := decryptSeed(encryptionKey, attrs[pair.encrypted])
+			if err != nil {
+				return fmt.Errorf("%s: %w", pair.encrypted, err)
+			}
+			kp, err := keypairFromSeed(seed)
+			if err != nil {
+				return fmt.Errorf("%s: %w", pair.encrypted, err)
+			}
+			pub, err := kp.PublicKey()
+			if err != nil {
+				return fmt.Errorf("%s: %w", pair.encrypted, err)
+			}
+			if pub != attrs[pair.public] {
+				return fmt.Errorf("%s decrypts to a seed for %s, want %s", pair.encrypted, pub, attrs[pair.public])
+			}
+			seedBase64, _, err := nkeyRawMaterial(seed)
+			if err != nil {
+				return fmt.Errorf("%s: %w", pair.encrypted, err)
+			}
+			for name, value := range attrs {
+				if strings.Contains(value, seed) || value == seedBase64 {
+					return fmt.Errorf("plaintext seed found in state attribute %s", name)
+				}
+			}
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.test", "seed"),
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.test", "signing_seed"),
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.test", "seed_base64"),
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.test", "seed_decorated"),
+					resource.TestCheckResourceAttrSet("natsjwt_nkey.test", "public_key_bytes_hex"),
+					resource.TestCheckResourceAttrSet("natsjwt_nkey.test", "seed_encrypted"),
+					resource.TestCheckResourceAttrSet("natsjwt_nkey.test", "signing_seed_encrypted"),
+					checkEncrypted,
+				),
+			},
+			{
+				// Refresh runs Read, which decrypts the seed to verify the public
+				// key but must not write it back to state
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.test", "seed"),
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.test", "signing_seed"),
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.test", "seed_base64"),
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.test", "seed_decorated"),
+					checkEncrypted,
+				),
+			},
+		},
+	})
+}
+
+func TestDecryptSeed_WrongPassphrase(t *testing.T) {
+	kp, err := nkeys.CreateAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	seed, err := kp.Seed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := encryptSeed("first", string(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := encryptSeed("first", string(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encrypted == again {
+		t.Fatal("encrypting twice must use a fresh salt and nonce")
+	}
+	if _, err := decryptSeed("second", encrypted); err == nil {
+		t.Fatal("expected decryption with the wrong passphrase to fail")
+	}
+}
+
+func TestAccNkeyResource_NoSeedEncryption(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "natsjwt_nkey" "test" { type = "user" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("natsjwt_nkey.test", "seed_encrypted"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNkeyResource_SeedDecorated(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "natsjwt_nkey" "test" { type = "operator" }`,
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["natsjwt_nkey.test"]
+					if !ok {
+						return fmt.Errorf("not found")
+					}
+					decorated := rs.Primary.Attributes["seed_decorated"]
+					if !strings.Contains(decorated, "-----BEGIN OPERATOR NKEY SEED-----") {
+						return fmt.Errorf("seed_decorated missing BEGIN marker: %q", decorated)
+					}
+					kp, err := natsjwt.ParseDecoratedNKey([]byte(decorated))
+					if err != nil {
+						return fmt.Errorf("failed to parse seed_decorated: %w", err)
+					}
+					seed, err := kp.Seed()
+					if err != nil {
+						return err
+					}
+					if string(seed) != rs.Primary.Attributes["seed"] {
+						return fmt.Errorf("seed_decorated does not contain the seed")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccNkeyResource_RawKeyMaterial(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "natsjwt_nkey" "test" { type = "account" }`,
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["natsjwt_nkey.test"]
+					if !ok {
+						return fmt.Errorf("not found")
+					}
+					attrs := rs.Primary.Attributes
+
+					raw, err := base64.StdEncoding.DecodeString(attrs["seed_base64"])
+					if err != nil {
+						return fmt.Errorf("failed to decode seed_base64: %w", err)
+					}
+					seed, err := nkeys.EncodeSeed(nkeys.PrefixByteAccount, raw)
+					if err != nil {
+						return fmt.Errorf("failed to re-encode seed: %w", err)
+					}
+					if string(seed) != attrs["seed"] {
+						return fmt.Errorf("seed_base64 re-encodes to %s, not to the seed", seed)
+					}
+
+					pub, err := nkeys.Decode(nkeys.PrefixByteAccount, []byte(attrs["public_key"]))
+					if err != nil {
+						return fmt.Errorf("failed to decode public_key: %w", err)
+					}
+					if got := attrs["public_key_bytes_hex"]; got != hex.EncodeToString(pub) {
+						return fmt.Errorf("expected public_key_bytes_hex %x, got %s", pub, got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccNkeyResource_WithSigningKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "natsjwt_nkey" "test" {
+  type             = "account"
+  with_signing_key = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("natsjwt_nkey.test", "seed", regexp.MustCompile(`^SA`)),
+					resource.TestMatchResourceAttr("natsjwt_nkey.test", "signing_seed", regexp.MustCompile(`^SA`)),
+					resource.TestMatchResourceAttr("natsjwt_nkey.test", "signing_public_key", regexp.MustCompile(`^A`)),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["natsjwt_nkey.test"]
+						if rs.Primary.Attributes["signing_public_key"] == rs.Primary.Attributes["public_key"] {
+							return fmt.Errorf("signing key must differ from the main key")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccNkeyResource_WithSigningKeyUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "natsjwt_nkey" "test" {
+  type             = "user"
+  with_signing_key = true
+}
+`,
+				ExpectError: regexp.MustCompile(`only supported for operator and account keys`),
+			},
+		},
+	})
+}
+
+func TestNkeyResource_ReadRepairsPublicKey(t *testing.T) {
+	ctx := context.Background()
+	r := &NkeyResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	seed, pub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+	_, otherPub := testSeedAndPublicKey(t, nkeys.PrefixByteAccount)
+
+	state := func(seed string, pub string) tfsdk.State {
+		values := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+		for name, typ := range objType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		values["type"] = tftypes.NewValue(tftypes.String, "account")
+		values["seed"] = tftypes.NewValue(tftypes.String, seed)
+		values["public_key"] = tftypes.NewValue(tftypes.String, pub)
+		values["with_signing_key"] = tftypes.NewValue(tftypes.Bool, false)
+		return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)}
+	}
+
+	t.Run("tampered", func(t *testing.T) {
+		resp := &fwresource.ReadResponse{State: state(seed, otherPub)}
+		r.Read(ctx, fwresource.ReadRequest{State: state(seed, otherPub)}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+		}
+		if resp.Diagnostics.WarningsCount() != 1 {
+			t.Fatalf("expected one warning, got %v", resp.Diagnostics)
+		}
+		if resp.State.Raw.IsNull() {
+			t.Fatal("resource was removed from state")
+		}
+		var data NkeyResourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		if got := data.PublicKey.ValueString(); got != pub {
+			t.Fatalf("expected public_key %s, got %s", pub, got)
+		}
+		if got := data.Seed.ValueString(); got != seed {
+			t.Fatal("seed changed on read")
+		}
+	})
+
+	t.Run("consistent", func(t *testing.T) {
+		resp := &fwresource.ReadResponse{State: state(seed, pub)}
+		r.Read(ctx, fwresource.ReadRequest{State: state(seed, pub)}, resp)
+		if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 0 {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("unparseable seed", func(t *testing.T) {
+		resp := &fwresource.ReadResponse{State: state("not-a-seed", pub)}
+		r.Read(ctx, fwresource.ReadRequest{State: state("not-a-seed", pub)}, resp)
+		if !resp.State.Raw.IsNull() {
+			t.Fatal("expected resource to be removed from state")
+		}
+	})
+}
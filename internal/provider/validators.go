@@ -3,8 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	natsjwt "github.com/nats-io/jwt/v2"
 	"github.com/nats-io/nkeys"
 )
 
@@ -50,9 +58,61 @@ func (v seedTypeValidator) ValidateString(_ context.Context, req validator.Strin
 	}
 }
 
+// accountSignerSeedValidator validates the seed that signs an account JWT. It must be
+// an operator seed, or an account seed when allow_account_signing is true.
+type accountSignerSeedValidator struct{}
+
+func AccountSignerSeedValidator() validator.String {
+	return accountSignerSeedValidator{}
+}
+
+func (v accountSignerSeedValidator) Description(_ context.Context) string {
+	return "must be a valid NKey seed of type operator, or of type account when allow_account_signing is true"
+}
+
+func (v accountSignerSeedValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v accountSignerSeedValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	prefix, _, err := nkeys.DecodeSeed([]byte(req.ConfigValue.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid NKey Seed",
+			fmt.Sprintf("Could not decode seed: %s", err),
+		)
+		return
+	}
+	if prefix == nkeys.PrefixByteOperator {
+		return
+	}
+
+	if prefix == nkeys.PrefixByteAccount {
+		var allow types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("allow_account_signing"), &allow)...)
+		// An unknown flag is checked again when the account is signed.
+		if allow.IsUnknown() || allow.ValueBool() {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Wrong NKey Seed Type",
+		fmt.Sprintf("Expected operator seed, got %s seed", prefixName(prefix)),
+	)
+}
+
 // publicKeyTypeValidator validates that a string is a valid NKey public key of the expected type.
+// When allowWildcard is set, the literal "*" (all keys) is accepted as well.
 type publicKeyTypeValidator struct {
-	expectedType nkeys.PrefixByte
+	expectedType  nkeys.PrefixByte
+	allowWildcard bool
 }
 
 func PublicKeyTypeValidator(expectedType nkeys.PrefixByte) validator.String {
@@ -60,6 +120,9 @@ func PublicKeyTypeValidator(expectedType nkeys.PrefixByte) validator.String {
 }
 
 func (v publicKeyTypeValidator) Description(_ context.Context) string {
+	if v.allowWildcard {
+		return fmt.Sprintf("must be a valid NKey public key of type %s or *", prefixName(v.expectedType))
+	}
 	return fmt.Sprintf("must be a valid NKey public key of type %s", prefixName(v.expectedType))
 }
 
@@ -73,6 +136,16 @@ func (v publicKeyTypeValidator) ValidateString(_ context.Context, req validator.
 	}
 
 	key := req.ConfigValue.ValueString()
+	if key == natsjwt.All {
+		if !v.allowWildcard {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Wildcard Public Key Not Allowed",
+				"The value must be a concrete NKey public key; * is not accepted here",
+			)
+		}
+		return
+	}
 	if !nkeys.IsValidPublicKey(key) {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
@@ -103,6 +176,76 @@ func (v publicKeyTypeValidator) ValidateString(_ context.Context, req validator.
 	}
 }
 
+// publicKeyMapKeysValidator validates that every key of a map is an NKey public key of the expected type.
+type publicKeyMapKeysValidator struct {
+	keyValidator publicKeyTypeValidator
+}
+
+// PublicKeyMapKeysValidator checks map keys against the expected key type.
+// Set allowWildcard for maps such as revocation lists where * means every key.
+func PublicKeyMapKeysValidator(expectedType nkeys.PrefixByte, allowWildcard bool) validator.Map {
+	return publicKeyMapKeysValidator{keyValidator: publicKeyTypeValidator{expectedType: expectedType, allowWildcard: allowWildcard}}
+}
+
+func (v publicKeyMapKeysValidator) Description(ctx context.Context) string {
+	return "map keys " + v.keyValidator.Description(ctx)
+}
+
+func (v publicKeyMapKeysValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v publicKeyMapKeysValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for key := range req.ConfigValue.Elements() {
+		keyResp := &validator.StringResponse{}
+		v.keyValidator.ValidateString(ctx, validator.StringRequest{
+			Path:        req.Path.AtMapKey(key),
+			ConfigValue: types.StringValue(key),
+		}, keyResp)
+		resp.Diagnostics.Append(keyResp.Diagnostics...)
+	}
+}
+
+// publicKeyListValidator validates that every element of a list is an NKey public key of the expected type.
+type publicKeyListValidator struct {
+	elemValidator publicKeyTypeValidator
+}
+
+func PublicKeyListValidator(expectedType nkeys.PrefixByte) validator.List {
+	return publicKeyListValidator{elemValidator: publicKeyTypeValidator{expectedType: expectedType}}
+}
+
+func (v publicKeyListValidator) Description(ctx context.Context) string {
+	return "each element " + v.elemValidator.Description(ctx)
+}
+
+func (v publicKeyListValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v publicKeyListValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, elem := range req.ConfigValue.Elements() {
+		str, ok := elem.(types.String)
+		if !ok || str.IsNull() || str.IsUnknown() {
+			continue
+		}
+		elemResp := &validator.StringResponse{}
+		v.elemValidator.ValidateString(ctx, validator.StringRequest{
+			Path:        req.Path.AtListIndex(i),
+			ConfigValue: str,
+		}, elemResp)
+		resp.Diagnostics.Append(elemResp.Diagnostics...)
+	}
+}
+
 // nkeyTypeValidator validates that a string is one of the valid NKey types.
 type nkeyTypeValidator struct{}
 
@@ -173,6 +316,566 @@ func (v connectionTypeValidator) ValidateString(_ context.Context, req validator
 	}
 }
 
+// exportTypeValidator validates export type strings.
+type exportTypeValidator struct{}
+
+func ExportTypeValidator() validator.String {
+	return exportTypeValidator{}
+}
+
+func (v exportTypeValidator) Description(_ context.Context) string {
+	return "must be a valid export type: service, stream"
+}
+
+func (v exportTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v exportTypeValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	val := req.ConfigValue.ValueString()
+	switch val {
+	case "service", "stream":
+		return
+	default:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Export Type",
+			fmt.Sprintf("Must be one of: service, stream. Got: %s", val),
+		)
+	}
+}
+
+// configOutputModeValidator validates config helper output mode strings.
+type configOutputModeValidator struct{}
+
+func ConfigOutputModeValidator() validator.String {
+	return configOutputModeValidator{}
+}
+
+func (v configOutputModeValidator) Description(_ context.Context) string {
+	return "must be a valid output mode: single, split, resolver_dir"
+}
+
+func (v configOutputModeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v configOutputModeValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	val := req.ConfigValue.ValueString()
+	switch val {
+	case "single", "split", "resolver_dir":
+		return
+	default:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Output Mode",
+			fmt.Sprintf("Must be one of: single, split, resolver_dir. Got: %s", val),
+		)
+	}
+}
+
+// responseTypeValidator validates response type strings.
+type responseTypeValidator struct{}
+
+func ResponseTypeValidator() validator.String {
+	return responseTypeValidator{}
+}
+
+func (v responseTypeValidator) Description(_ context.Context) string {
+	return "must be a valid NATS response type: singleton, stream, chunked"
+}
+
+func (v responseTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v responseTypeValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	val := req.ConfigValue.ValueString()
+	switch val {
+	case "singleton", "stream", "chunked":
+		return
+	default:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Response Type",
+			fmt.Sprintf("Must be one of: singleton, stream, chunked. Got: %s", val),
+		)
+	}
+}
+
+var jetStreamTierPattern = regexp.MustCompile(`^R[1-9][0-9]*$`)
+
+// jetStreamTiersValidator validates that JetStream limit tiers are well-formed and unique.
+// It also warns about max_bytes_required on entries without per-stream byte limits.
+type jetStreamTiersValidator struct{}
+
+func JetStreamTiersValidator() validator.List {
+	return jetStreamTiersValidator{}
+}
+
+func (v jetStreamTiersValidator) Description(_ context.Context) string {
+	return "tiers must match R<replicas> (e.g., R1, R3) and must not be repeated; max_bytes_required should come with a per-stream byte limit"
+}
+
+func (v jetStreamTiersValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jetStreamTiersValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var limits []JetStreamLimitsModel
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &limits, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool)
+	var globalIndex, tieredIndex = -1, -1
+	for i, jsl := range limits {
+		if jsl.MaxBytesRequired.ValueBool() && !hasStreamByteLimit(jsl) {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path.AtListIndex(i).AtName("max_bytes_required"),
+				"Max Bytes Required Without Stream Limits",
+				"max_bytes_required is true but neither mem_max_stream_bytes nor disk_max_stream_bytes is set to a positive value, so streams may still request unlimited max_bytes",
+			)
+		}
+
+		if jsl.Tier.IsUnknown() {
+			continue
+		}
+		tier := jsl.Tier.ValueString()
+		tierPath := req.Path.AtListIndex(i).AtName("tier")
+
+		if tier != "" && !jetStreamTierPattern.MatchString(tier) {
+			resp.Diagnostics.AddAttributeError(
+				tierPath,
+				"Invalid JetStream Tier",
+				fmt.Sprintf("Tier must match R<replicas> (e.g., R1, R3). Got: %s", tier),
+			)
+			continue
+		}
+
+		if seen[tier] {
+			name := tier
+			if name == "" {
+				name = "global (no tier)"
+			}
+			resp.Diagnostics.AddAttributeError(
+				tierPath,
+				"Duplicate JetStream Tier",
+				fmt.Sprintf("JetStream limits for tier %s are defined more than once", name),
+			)
+			continue
+		}
+		seen[tier] = true
+		if tier == "" {
+			globalIndex = i
+		} else if tieredIndex < 0 {
+			tieredIndex = i
+		}
+	}
+
+	if globalIndex >= 0 && tieredIndex >= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtListIndex(globalIndex).AtName("tier"),
+			"Conflicting JetStream Limits",
+			fmt.Sprintf("A global (tierless) entry cannot be combined with tiered entries such as %s. NATS treats global and tiered JetStream limits as mutually exclusive and rejects account JWTs that set both; cap aggregate storage through each tier's mem_storage and disk_storage instead.", limits[tieredIndex].Tier.ValueString()),
+		)
+	}
+}
+
+// hasStreamByteLimit reports whether a JetStream limits entry sets, or may set, a per-stream byte limit.
+func hasStreamByteLimit(jsl JetStreamLimitsModel) bool {
+	for _, v := range []types.Int64{jsl.MemMaxStreamBytes, jsl.DiskMaxStreamBytes} {
+		if v.IsUnknown() || v.ValueInt64() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// extraClaimsValidator validates that a string is a JSON object without protected claim fields.
+type extraClaimsValidator struct{}
+
+func ExtraClaimsValidator() validator.String {
+	return extraClaimsValidator{}
+}
+
+func (v extraClaimsValidator) Description(_ context.Context) string {
+	return "must be a JSON object that does not set standard JWT fields"
+}
+
+func (v extraClaimsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v extraClaimsValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseExtraClaims(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Extra Claims",
+			fmt.Sprintf("extra_claims %s", err),
+		)
+	}
+}
+
+// subjectListValidator validates that every element of a list is a well-formed NATS subject.
+type subjectListValidator struct{}
+
+func SubjectListValidator() validator.List {
+	return subjectListValidator{}
+}
+
+func (v subjectListValidator) Description(_ context.Context) string {
+	return "each element must be a valid NATS subject"
+}
+
+func (v subjectListValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v subjectListValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var subjects []types.String
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &subjects, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, subject := range subjects {
+		if subject.IsNull() || subject.IsUnknown() {
+			continue
+		}
+		if hasQueueGroup(subject.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Queue Group Not Allowed",
+				fmt.Sprintf("%q uses queue-group syntax (subject followed by a queue name). Permissions apply to subjects only, so queue groups are not valid here.", subject.ValueString()),
+			)
+			continue
+		}
+		if err := validateSubject(subject.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid Subject",
+				fmt.Sprintf("%q is not a valid NATS subject: %s", subject.ValueString(), err),
+			)
+		}
+	}
+}
+
+// subjectNamespaceValidator validates that a string is a literal subject usable as a prefix.
+type subjectNamespaceValidator struct{}
+
+func SubjectNamespaceValidator() validator.String {
+	return subjectNamespaceValidator{}
+}
+
+func (v subjectNamespaceValidator) Description(_ context.Context) string {
+	return "must be a valid NATS subject without wildcards"
+}
+
+func (v subjectNamespaceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v subjectNamespaceValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	namespace := req.ConfigValue.ValueString()
+	err := validateSubject(namespace)
+	if err == nil && strings.ContainsAny(namespace, "*>") {
+		err = fmt.Errorf("namespace must not contain wildcards")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Subject Namespace",
+			fmt.Sprintf("Subject namespace %q is invalid: %s", namespace, err),
+		)
+	}
+}
+
+// validateSubject checks a subject, possibly containing wildcards, for the mistakes
+// nats-server would reject: empty tokens, whitespace and misplaced wildcards.
+func validateSubject(subject string) error {
+	if subject == "" {
+		return fmt.Errorf("subject is empty")
+	}
+	if strings.ContainsAny(subject, " \t\r\n") {
+		return fmt.Errorf("subject contains whitespace")
+	}
+	tokens := strings.Split(subject, ".")
+	for i, token := range tokens {
+		switch {
+		case token == "":
+			return fmt.Errorf("subject contains an empty token")
+		case token == ">" && i != len(tokens)-1:
+			return fmt.Errorf("'>' wildcard must be the last token")
+		case token != "*" && token != ">" && strings.ContainsAny(token, "*>"):
+			return fmt.Errorf("wildcards must be whole tokens")
+		}
+	}
+	return nil
+}
+
+// normalizeSubject trims surrounding whitespace from subject and checks the
+// result with validateSubject.
+func normalizeSubject(subject string) (string, error) {
+	normalized := strings.TrimSpace(subject)
+	if err := validateSubject(normalized); err != nil {
+		return "", err
+	}
+	return normalized, nil
+}
+
+// portValidator validates that an integer is a usable TCP port.
+type portValidator struct{}
+
+func PortValidator() validator.Int64 {
+	return portValidator{}
+}
+
+func (v portValidator) Description(_ context.Context) string {
+	return "must be a TCP port between 1 and 65535"
+}
+
+func (v portValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portValidator) ValidateInt64(_ context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if port := req.ConfigValue.ValueInt64(); port < 1 || port > 65535 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Port",
+			fmt.Sprintf("Port must be between 1 and 65535. Got: %d", port),
+		)
+	}
+}
+
+// maxNameLength is the longest operator, account or user name accepted.
+const maxNameLength = 256
+
+// nameValidator validates that an entity name is non-empty, has no surrounding
+// whitespace and is at most maxNameLength characters long.
+type nameValidator struct{}
+
+func NameValidator() validator.String {
+	return nameValidator{}
+}
+
+func (v nameValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("must be a non-empty name of at most %d characters without leading or trailing whitespace", maxNameLength)
+}
+
+func (v nameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v nameValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateName(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Name", "Name "+err.Error())
+	}
+}
+
+// validateName checks an entity name against the rules of NameValidator.
+func validateName(name string) error {
+	switch {
+	case strings.TrimSpace(name) == "":
+		return fmt.Errorf("must not be empty")
+	case strings.TrimSpace(name) != name:
+		return fmt.Errorf("must not have leading or trailing whitespace. Got: %q", name)
+	case utf8.RuneCountInString(name) > maxNameLength:
+		return fmt.Errorf("must be at most %d characters long. Got %d characters", maxNameLength, utf8.RuneCountInString(name))
+	}
+	return nil
+}
+
+// natsURLValidator validates that every element of a list is a URL clients can
+// connect to: nats, tls, ws or wss, with a host and no credentials or path.
+type natsURLValidator struct{}
+
+func NatsURLValidator() validator.List {
+	return natsURLValidator{}
+}
+
+func (v natsURLValidator) Description(_ context.Context) string {
+	return "each element must be a nats://, tls://, ws:// or wss:// URL with a host"
+}
+
+func (v natsURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v natsURLValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var urls []types.String
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &urls, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, u := range urls {
+		if u.IsNull() || u.IsUnknown() {
+			continue
+		}
+		if err := validateNatsURL(u.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), "Invalid NATS URL", err.Error())
+		}
+	}
+}
+
+// validateNatsURL applies the jwt library's operator service URL rules and
+// additionally requires a host, which the library does not check.
+func validateNatsURL(v string) error {
+	if v == "" {
+		return fmt.Errorf("URL is empty")
+	}
+	if err := natsjwt.ValidateOperatorServiceURL(v); err != nil {
+		return err
+	}
+	if u, _ := url.Parse(v); u.Host == "" {
+		return fmt.Errorf("URL %q has no host", v)
+	}
+	return nil
+}
+
+// accountServerURLValidator validates the operator account_server_url. Account
+// servers are usually HTTP endpoints with a path, e.g. https://host/jwt/v1, so
+// http and https are accepted alongside the NATS schemes.
+type accountServerURLValidator struct{}
+
+func AccountServerURLValidator() validator.String {
+	return accountServerURLValidator{}
+}
+
+func (v accountServerURLValidator) Description(_ context.Context) string {
+	return "must be an http, https, nats, tls, ws or wss URL with a host"
+}
+
+func (v accountServerURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v accountServerURLValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	u, err := url.Parse(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Account Server URL",
+			fmt.Sprintf("%q is not a valid URL: %s", value, err))
+		return
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "nats", "tls", "ws", "wss":
+	default:
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Account Server URL",
+			fmt.Sprintf("%q must use one of the http, https, nats, tls, ws or wss schemes", value))
+		return
+	}
+	if u.Host == "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Account Server URL",
+			fmt.Sprintf("%q has no host", value))
+	}
+}
+
+// timeRestrictionsValidator warns about user time ranges whose end is before
+// their start. nats-server treats such a range as wrapping midnight, which is
+// often a mistake. Values that are not HH:MM:SS are left to nats-server.
+type timeRestrictionsValidator struct{}
+
+func TimeRestrictionsValidator() validator.List {
+	return timeRestrictionsValidator{}
+}
+
+func (v timeRestrictionsValidator) Description(_ context.Context) string {
+	return "ranges whose end is before their start wrap midnight"
+}
+
+func (v timeRestrictionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeRestrictionsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var ranges []TimeRangeModel
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &ranges, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, tr := range ranges {
+		if tr.Start.IsUnknown() || tr.End.IsUnknown() {
+			continue
+		}
+		start, err := time.Parse(time.TimeOnly, tr.Start.ValueString())
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(time.TimeOnly, tr.End.ValueString())
+		if err != nil {
+			continue
+		}
+		if end.Before(start) {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path.AtListIndex(i).AtName("end"),
+				"Time Range Wraps Midnight",
+				fmt.Sprintf("end %s is before start %s, so the range runs from %s until %s the next day. Swap start and end if that is not intended.",
+					tr.End.ValueString(), tr.Start.ValueString(), tr.Start.ValueString(), tr.End.ValueString()),
+			)
+		}
+	}
+}
+
+// hasQueueGroup reports whether subject is written as "<subject> <queue>", the
+// form used for queue subscriptions.
+func hasQueueGroup(subject string) bool {
+	parts := strings.Split(subject, " ")
+	return len(parts) == 2 && parts[0] != "" && parts[1] != "" && !strings.ContainsAny(subject, "\t\r\n")
+}
+
 func prefixName(p nkeys.PrefixByte) string {
 	switch p {
 	case nkeys.PrefixByteOperator: